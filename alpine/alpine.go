@@ -0,0 +1,43 @@
+// Package alpine provides attributes for Alpine.js, see https://alpinejs.dev
+// Alpine's shorthand attributes like "@click" and ":class" aren't valid Go identifiers, so use On and Bind for them.
+package alpine
+
+import (
+	g "github.com/maragudk/gomponents"
+)
+
+// On returns an attribute with name "@" followed by the event, like "@click", with the given handler expression.
+// Modifiers can be part of the event, like "submit.prevent".
+func On(event, handler string) g.Node {
+	return g.Attr("@"+event, handler)
+}
+
+// Bind returns an attribute with name ":" followed by the given name, like ":class", with the given expression.
+func Bind(name, expression string) g.Node {
+	return g.Attr(":"+name, expression)
+}
+
+// Data returns an attribute with name "x-data" for the component state, as a JavaScript object.
+func Data(v string) g.Node {
+	return g.Attr("x-data", v)
+}
+
+// Init returns an attribute with name "x-init" for an expression to run when the component is initialized.
+func Init(expression string) g.Node {
+	return g.Attr("x-init", expression)
+}
+
+// Show returns an attribute with name "x-show" for whether the element is shown.
+func Show(expression string) g.Node {
+	return g.Attr("x-show", expression)
+}
+
+// Text returns an attribute with name "x-text" for the text content of the element.
+func Text(expression string) g.Node {
+	return g.Attr("x-text", expression)
+}
+
+// Model returns an attribute with name "x-model" for the state the value of the input element is bound to.
+func Model(expression string) g.Node {
+	return g.Attr("x-model", expression)
+}
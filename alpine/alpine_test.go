@@ -0,0 +1,50 @@
+package alpine_test
+
+import (
+	"testing"
+
+	"github.com/maragudk/gomponents/alpine"
+	"github.com/maragudk/gomponents/assert"
+)
+
+func TestOn(t *testing.T) {
+	t.Run("returns an @event attribute with the given handler", func(t *testing.T) {
+		assert.Equal(t, ` @click.outside="open = false"`, alpine.On("click.outside", "open = false"))
+	})
+}
+
+func TestBind(t *testing.T) {
+	t.Run("returns a :name attribute with the given expression", func(t *testing.T) {
+		assert.Equal(t, ` :class="open ? &#39;hat&#39; : &#39;&#39;"`, alpine.Bind("class", "open ? 'hat' : ''"))
+	})
+}
+
+func TestData(t *testing.T) {
+	t.Run("returns an x-data attribute with the given value", func(t *testing.T) {
+		assert.Equal(t, ` x-data="{ open: false }"`, alpine.Data(`{ open: false }`))
+	})
+}
+
+func TestInit(t *testing.T) {
+	t.Run("returns an x-init attribute with the given value", func(t *testing.T) {
+		assert.Equal(t, ` x-init="load()"`, alpine.Init(`load()`))
+	})
+}
+
+func TestShow(t *testing.T) {
+	t.Run("returns an x-show attribute with the given value", func(t *testing.T) {
+		assert.Equal(t, ` x-show="open"`, alpine.Show(`open`))
+	})
+}
+
+func TestText(t *testing.T) {
+	t.Run("returns an x-text attribute with the given value", func(t *testing.T) {
+		assert.Equal(t, ` x-text="hat.name"`, alpine.Text(`hat.name`))
+	})
+}
+
+func TestModel(t *testing.T) {
+	t.Run("returns an x-model attribute with the given value", func(t *testing.T) {
+		assert.Equal(t, ` x-model="hat"`, alpine.Model(`hat`))
+	})
+}
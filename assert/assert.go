@@ -1,6 +1,10 @@
+// Package assert provides test helpers for rendered Nodes.
 package assert
 
 import (
+	"context"
+	"fmt"
+	"strings"
 	"testing"
 
 	g "github.com/maragudk/gomponents"
@@ -13,3 +17,62 @@ func Equal(t *testing.T, expected string, actual g.Node) {
 		t.FailNow()
 	}
 }
+
+// Equivalent checks that the expected and actual Nodes render to equivalent HTML, ignoring the order of attributes
+// and insignificant whitespace between tags (see gomponents.RenderMinified).
+// If they differ, the failure shows both with one element per line, and points at the first line that differs.
+func Equivalent(t testing.TB, expected, actual g.Node) {
+	t.Helper()
+	e, err := normalize(expected)
+	if err != nil {
+		t.Fatalf("cannot normalize expected node: %v", err)
+	}
+	a, err := normalize(actual)
+	if err != nil {
+		t.Fatalf("cannot normalize actual node: %v", err)
+	}
+	if e == a {
+		return
+	}
+
+	el, al := strings.Split(e, "\n"), strings.Split(a, "\n")
+	line := 0
+	for line < len(el) && line < len(al) && el[line] == al[line] {
+		line++
+	}
+	t.Fatalf("rendered nodes differ at line %v:\n  expected: %v\n  actual:   %v\n\nexpected:\n%v\nactual:\n%v",
+		line+1, lineAt(el, line), lineAt(al, line), e, a)
+}
+
+// normalize n by rendering it with one element per line, sorted attributes, and no insignificant whitespace.
+// The HTML is parsed first, so that all attributes are sorted, not just the ones created with Attr.
+func normalize(n g.Node) (string, error) {
+	parsed, err := g.Parse(strings.NewReader(n.Render()))
+	if err != nil {
+		return "", err
+	}
+	var sorted strings.Builder
+	if err := g.RenderContext(g.WithSortedAttributes(context.Background()), &sorted, parsed); err != nil {
+		return "", err
+	}
+	var minified strings.Builder
+	if err := g.RenderMinified(&minified, g.Raw(sorted.String())); err != nil {
+		return "", err
+	}
+	parsed, err = g.Parse(strings.NewReader(minified.String()))
+	if err != nil {
+		return "", err
+	}
+	var indented strings.Builder
+	if err := g.RenderIndented(&indented, parsed, "  "); err != nil {
+		return "", err
+	}
+	return indented.String(), nil
+}
+
+func lineAt(lines []string, i int) string {
+	if i >= len(lines) {
+		return "(end)"
+	}
+	return fmt.Sprintf("`%v`", lines[i])
+}
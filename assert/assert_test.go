@@ -0,0 +1,69 @@
+package assert_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	g "github.com/maragudk/gomponents"
+	"github.com/maragudk/gomponents/assert"
+)
+
+func TestEquivalent(t *testing.T) {
+	t.Run("passes for nodes that differ in attribute order and whitespace", func(t *testing.T) {
+		expected := g.El("div", g.Attr("id", "hat"), g.Attr("class", "party"), g.Text("\n  "), g.El("p", g.Text("Hat")), g.Text("\n"))
+		actual := g.Raw(`<div class="party" id="hat"><p>Hat</p></div>`)
+		assert.Equivalent(t, expected, actual)
+	})
+
+	t.Run("fails with the first differing line", func(t *testing.T) {
+		ft := &fakeT{TB: t}
+		func() {
+			defer func() { _ = recover() }()
+			assert.Equivalent(ft, g.El("ul", g.El("li", g.Text("Partyhat")), g.El("li", g.Text("Turtlehat"))),
+				g.El("ul", g.El("li", g.Text("Partyhat")), g.El("li", g.Text("Tophat"))))
+		}()
+		if !strings.HasPrefix(ft.msg, "rendered nodes differ at line 3:\n  expected: `  <li>Turtlehat</li>`\n  actual:   `  <li>Tophat</li>`") {
+			t.Fatalf("unexpected failure message %v", ft.msg)
+		}
+	})
+
+	t.Run("compares table rows as the root", func(t *testing.T) {
+		assert.Equivalent(t, g.El("tr", g.Attr("class", "a"), g.Attr("id", "hat"), g.El("td", g.Text("1"))),
+			g.Raw(`<tr id="hat" class="a"><td>1</td></tr>`))
+
+		ft := &fakeT{TB: t}
+		func() {
+			defer func() { _ = recover() }()
+			assert.Equivalent(ft, g.El("tr", g.Attr("class", "a"), g.El("td", g.Text("1"))),
+				g.El("tr", g.Attr("class", "b"), g.El("td", g.Text("1"))))
+		}()
+		if !strings.HasPrefix(ft.msg, "rendered nodes differ at line 1:\n  expected: `<tr class=\"a\">`\n  actual:   `<tr class=\"b\">`") {
+			t.Fatalf("unexpected failure message %v", ft.msg)
+		}
+	})
+
+	t.Run("fails if the actual node has extra content", func(t *testing.T) {
+		ft := &fakeT{TB: t}
+		func() {
+			defer func() { _ = recover() }()
+			assert.Equivalent(ft, g.El("p"), g.Group([]g.Node{g.El("p"), g.El("p")}))
+		}()
+		if !strings.HasPrefix(ft.msg, "rendered nodes differ at line 2:\n  expected: ``\n  actual:   `<p></p>`") {
+			t.Fatalf("unexpected failure message %v", ft.msg)
+		}
+	})
+}
+
+// fakeT records the failure message instead of failing the test, and stops the helper with a panic.
+type fakeT struct {
+	testing.TB
+	msg string
+}
+
+func (t *fakeT) Helper() {}
+
+func (t *fakeT) Fatalf(format string, args ...interface{}) {
+	t.msg = fmt.Sprintf(format, args...)
+	panic("fatal")
+}
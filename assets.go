@@ -0,0 +1,258 @@
+package gomponents
+
+import (
+	"context"
+	"io"
+	"strings"
+)
+
+// RequireAsset returns a Node that renders nothing where it's placed, but makes HoistAssets render asset
+// in the document head, for components that need a stylesheet or script, like
+// RequireAsset(components.StyleSheet("/datepicker.css")).
+// Assets that render the same are only rendered once, no matter how many components require them.
+// It's placed Inside, so it can be placed anywhere, including in void elements.
+func RequireAsset(asset Node) Node {
+	return requiredAsset{asset: asset}
+}
+
+type requiredAsset struct {
+	asset Node
+}
+
+func (r requiredAsset) Render() string {
+	return ""
+}
+
+// RenderTo satisfies Renderer.
+func (r requiredAsset) RenderTo(w io.Writer) error {
+	return r.RenderContext(writerContext(w), w)
+}
+
+// RenderContext satisfies ContextNode. It adds the asset to the assets collected by HoistAssets, if any.
+func (r requiredAsset) RenderContext(ctx context.Context, w io.Writer) error {
+	c, ok := ctx.Value(assetsKey{}).(*assetCollector)
+	if !ok || c == nil || r.asset == nil {
+		return nil
+	}
+	return c.collect(ctx, r.asset)
+}
+
+func (r requiredAsset) Place() Placement {
+	return Inside
+}
+
+type assetsKey struct{}
+
+// assetCollector collects the rendered assets from RequireAsset, in the order they were first required.
+type assetCollector struct {
+	seen   map[string]struct{}
+	assets []Node
+}
+
+// collect the rendered n, if nothing rendered the same has been collected already.
+func (c *assetCollector) collect(ctx context.Context, n Node) error {
+	var b strings.Builder
+	if err := RenderContext(ctx, &b, n); err != nil {
+		return err
+	}
+	c.addRendered(b.String())
+	return nil
+}
+
+// addRendered asset s, if it hasn't been added already.
+func (c *assetCollector) addRendered(s string) {
+	if _, ok := c.seen[s]; !ok {
+		c.seen[s] = struct{}{}
+		c.assets = append(c.assets, Raw(s))
+	}
+}
+
+// HoistAssets returns a Node that renders n with the assets required with RequireAsset anywhere in it
+// at the end of its "head" element, so they are rendered once, before the content that needs them.
+// Nodes placed in the Head, like with InHead, are hoisted the same way.
+// If there is no "head" element reachable through Elements and Groups (see Walk), like for a page fragment,
+// the assets are rendered before n instead.
+// Because the assets are only known after rendering n, n is rendered twice: first to collect the assets,
+// and then with them. So n must render the same both times, with any functions in it called twice.
+func HoistAssets(n Node) Node {
+	return hoistedAssets{n: n}
+}
+
+type hoistedAssets struct {
+	n Node
+}
+
+func (h hoistedAssets) Render() string {
+	return renderString(h)
+}
+
+// RenderTo satisfies Renderer.
+func (h hoistedAssets) RenderTo(w io.Writer) error {
+	return h.RenderContext(writerContext(w), w)
+}
+
+// RenderContext satisfies ContextNode.
+func (h hoistedAssets) RenderContext(ctx context.Context, w io.Writer) error {
+	c := &assetCollector{seen: map[string]struct{}{}}
+	collectCtx := context.WithValue(ctx, assetsKey{}, c)
+	// Errors from ErrNode are only collected in the second render, so they are reported once
+	if _, ok := ctx.Value(errorsKey{}).(*errorCollector); ok {
+		collectCtx = context.WithValue(collectCtx, errorsKey{}, &errorCollector{})
+	}
+	if err := RenderContext(collectCtx, io.Discard, h.n); err != nil {
+		return err
+	}
+	// Assets are not collected again in the second render
+	ctx = context.WithValue(ctx, assetsKey{}, (*assetCollector)(nil))
+
+	n, ok := addToHead(Group(c.assets), h.n)
+	if !ok {
+		n = Group([]Node{Group(c.assets), h.n})
+	}
+	return RenderContext(ctx, w, n)
+}
+
+func (h hoistedAssets) Place() Placement {
+	return placement(h.n)
+}
+
+// addToHead returns n with assets added to the end of the first "head" element in it, and whether there was one.
+func addToHead(assets Node, n Node) (Node, bool) {
+	switch n := n.(type) {
+	case group:
+		for i, c := range n.children {
+			if c, ok := addToHead(assets, c); ok {
+				children := make([]Node, len(n.children))
+				copy(children, n.children)
+				children[i] = c
+				return group{children: children}, true
+			}
+		}
+	case Element:
+		if n.name == "head" && !n.foreign {
+			return n.With(assets), true
+		}
+		for i, c := range n.children {
+			if c, ok := addToHead(assets, c); ok {
+				children := make([]Node, len(n.children))
+				copy(children, n.children)
+				children[i] = c
+				n.children = children
+				return n, true
+			}
+		}
+	}
+	return n, false
+}
+
+// InHead returns a Node that renders n in the "head" element of the document instead of where it's placed,
+// for components that need something there, like a meta element. See Head.
+// Rendering an "html" element moves the Nodes placed in the Head that are reachable through Elements and Groups
+// (see Walk) to the end of its "head" element. With HoistAssets, all Nodes placed in the Head are moved,
+// also the ones returned by functions when rendering, and the ones that render the same are only rendered once.
+// If there is no "head" element, n is rendered where it's placed.
+func InHead(n Node) Node {
+	return inHead{n: n}
+}
+
+type inHead struct {
+	n Node
+}
+
+func (h inHead) Render() string {
+	return renderString(h)
+}
+
+// RenderTo satisfies Renderer.
+func (h inHead) RenderTo(w io.Writer) error {
+	return h.RenderContext(writerContext(w), w)
+}
+
+// RenderContext satisfies ContextNode.
+func (h inHead) RenderContext(ctx context.Context, w io.Writer) error {
+	return renderHead(ctx, w, h.n)
+}
+
+func (h inHead) Place() Placement {
+	return Head
+}
+
+// renderHead renders n placed in the Head to w, or collects it if rendering for HoistAssets.
+func renderHead(ctx context.Context, w io.Writer, n Node) error {
+	if h, ok := n.(inHead); ok {
+		n = h.n
+	}
+	c, ok := ctx.Value(assetsKey{}).(*assetCollector)
+	if !ok {
+		return RenderContext(ctx, w, n)
+	}
+	if c == nil {
+		return nil
+	}
+	return c.collect(ctx, n)
+}
+
+// hoistHead returns the html element e with the Nodes placed in the Head moved to the end of its "head" element,
+// unless rendering for HoistAssets, which moves them itself.
+func hoistHead(ctx context.Context, e Element) Element {
+	if _, ok := ctx.Value(assetsKey{}).(*assetCollector); ok || !hasHeadPlaced(e.children) {
+		return e
+	}
+	var nodes []Node
+	removed := e
+	removed.children = removeHeadPlaced(e.children, &nodes)
+	n, ok := addToHead(Group(nodes), removed)
+	if !ok {
+		return e
+	}
+	return n.(Element)
+}
+
+// hasHeadPlaced returns whether any of the children outside "head" elements, including descendants, is placed in the Head.
+func hasHeadPlaced(children []Node) bool {
+	for _, c := range children {
+		switch c := c.(type) {
+		case group:
+			if hasHeadPlaced(c.children) {
+				return true
+			}
+		case Element:
+			if c.name != "head" && hasHeadPlaced(c.children) {
+				return true
+			}
+		case nil:
+		default:
+			if placement(c) == Head {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// removeHeadPlaced returns children without the descendants placed in the Head outside "head" elements,
+// which are appended to nodes.
+func removeHeadPlaced(children []Node, nodes *[]Node) []Node {
+	var kept []Node
+	for _, c := range children {
+		switch c := c.(type) {
+		case group:
+			kept = append(kept, group{children: removeHeadPlaced(c.children, nodes)})
+			continue
+		case Element:
+			if c.name != "head" && hasHeadPlaced(c.children) {
+				c.children = removeHeadPlaced(c.children, nodes)
+			}
+			kept = append(kept, c)
+			continue
+		case nil:
+			continue
+		}
+		if placement(c) == Head {
+			*nodes = append(*nodes, c)
+			continue
+		}
+		kept = append(kept, c)
+	}
+	return kept
+}
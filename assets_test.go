@@ -0,0 +1,97 @@
+package gomponents_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	g "github.com/maragudk/gomponents"
+	"github.com/maragudk/gomponents/assert"
+)
+
+func TestHoistAssets(t *testing.T) {
+	datePicker := func() g.Node {
+		return g.El("input", g.Attr("type", "date"), g.RequireAsset(g.El("link", g.Attr("rel", "stylesheet"), g.Attr("href", "/date.css"))))
+	}
+	chart := func() g.Node {
+		return g.El("div", g.Attr("class", "chart"), g.RequireAsset(g.El("script", g.Attr("src", "/chart.js"))))
+	}
+
+	t.Run("renders required assets once at the end of the head", func(t *testing.T) {
+		n := g.HoistAssets(g.Group([]g.Node{g.Raw("<!doctype html>"), g.El("html",
+			g.El("head", g.El("title", g.Text("Hats"))),
+			g.El("body", datePicker(), chart(), g.Lazy(datePicker)),
+		)}))
+		assert.Equal(t, `<!doctype html><html><head><title>Hats</title><link rel="stylesheet" href="/date.css" /><script src="/chart.js"></script></head>`+
+			`<body><input type="date" /><div class="chart"></div><input type="date" /></body></html>`, n)
+	})
+
+	t.Run("renders assets before the node without a head", func(t *testing.T) {
+		assert.Equal(t, `<script src="/chart.js"></script><div class="chart"></div>`, g.HoistAssets(chart()))
+	})
+
+	t.Run("renders nothing for required assets without HoistAssets", func(t *testing.T) {
+		assert.Equal(t, `<div class="chart"></div>`, chart())
+	})
+
+	t.Run("renders assets with the render context", func(t *testing.T) {
+		n := g.HoistAssets(g.El("html", g.El("head"), g.El("body", g.RequireAsset(g.El("style", g.Attr("title", "hat"), hatFromContext{})))))
+		var b strings.Builder
+		if err := g.RenderContext(context.WithValue(context.Background(), hatKey{}, "partyhat"), &b, n); err != nil {
+			t.Fatal(err)
+		}
+		if b.String() != `<html><head><style title="hat">partyhat</style></head><body></body></html>` {
+			t.Fatalf("unexpected output %v", b.String())
+		}
+	})
+
+	t.Run("errors on render error", func(t *testing.T) {
+		if err := g.Write(&strings.Builder{}, g.HoistAssets(g.El("div", erroringNode{}))); err == nil {
+			t.FailNow()
+		}
+		if err := g.Write(&strings.Builder{}, g.HoistAssets(g.RequireAsset(erroringNode{}))); err == nil {
+			t.FailNow()
+		}
+	})
+}
+
+func TestInHead(t *testing.T) {
+	description := func() g.Node {
+		return g.InHead(g.El("meta", g.Attr("name", "description"), g.Attr("content", "Hats")))
+	}
+
+	t.Run("renders nodes in the head of an html element", func(t *testing.T) {
+		n := g.El("html",
+			g.El("head", g.El("title", g.Text("Hats"))),
+			g.El("body", g.El("main", description(), g.Text("Hat")), g.Group([]g.Node{g.InHead(g.Raw("<style></style>"))})),
+		)
+		assert.Equal(t, `<html><head><title>Hats</title><meta name="description" content="Hats" /><style></style></head>`+
+			`<body><main>Hat</main></body></html>`, n)
+	})
+
+	t.Run("renders nodes in the head with HoistAssets, also from functions, once", func(t *testing.T) {
+		n := g.HoistAssets(g.El("html", g.El("head"), g.El("body", description(), g.Lazy(description))))
+		assert.Equal(t, `<html><head><meta name="description" content="Hats" /></head><body></body></html>`, n)
+	})
+
+	t.Run("renders nodes in the head when pretty-printing", func(t *testing.T) {
+		var b strings.Builder
+		if err := g.RenderIndented(&b, g.El("html", g.El("head"), g.El("body", g.El("p", description()))), ""); err != nil {
+			t.Fatal(err)
+		}
+		if b.String() != "<html>\n<head><meta name=\"description\" content=\"Hats\" /></head>\n<body>\n<p></p>\n</body>\n</html>\n" {
+			t.Fatalf("unexpected output %q", b.String())
+		}
+	})
+
+	t.Run("renders nodes in place without a head", func(t *testing.T) {
+		assert.Equal(t, `<div><meta name="description" content="Hats" /></div>`, g.El("div", description()))
+		assert.Equal(t, `<html><body><meta name="description" content="Hats" /></body></html>`, g.El("html", g.El("body", description())))
+	})
+
+	t.Run("is placed in the head", func(t *testing.T) {
+		if p := description().(g.Placer).Place(); p != g.Head {
+			t.Fatalf("expected Head, got %v", p)
+		}
+	})
+}
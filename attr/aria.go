@@ -0,0 +1,37 @@
+package attr
+
+import (
+	"strconv"
+	"strings"
+
+	g "github.com/maragudk/gomponents"
+)
+
+// Role returns an attribute with name "role" and the given value.
+func Role(v string) g.Node {
+	return g.Attr("role", v)
+}
+
+// Aria returns an attribute with name "aria-" followed by the given name, and the given value.
+// Like with Data, the name is lowercased and an "aria-" prefix is not repeated.
+func Aria(name, v string) g.Node {
+	name = strings.TrimPrefix(strings.ToLower(name), "aria-")
+	return g.Attr("aria-"+name, v)
+}
+
+// AriaLabel returns an attribute with name "aria-label" and the given value.
+func AriaLabel(v string) g.Node {
+	return Aria("label", v)
+}
+
+// AriaHidden returns an attribute with name "aria-hidden" and the value "true" or "false".
+// Note that ARIA uses string values for booleans, so the attribute is rendered in both cases.
+func AriaHidden(v bool) g.Node {
+	return Aria("hidden", strconv.FormatBool(v))
+}
+
+// AriaExpanded returns an attribute with name "aria-expanded" and the value "true" or "false".
+// Note that ARIA uses string values for booleans, so the attribute is rendered in both cases.
+func AriaExpanded(v bool) g.Node {
+	return Aria("expanded", strconv.FormatBool(v))
+}
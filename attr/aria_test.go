@@ -0,0 +1,44 @@
+package attr_test
+
+import (
+	"testing"
+
+	"github.com/maragudk/gomponents/assert"
+	"github.com/maragudk/gomponents/attr"
+)
+
+func TestRole(t *testing.T) {
+	t.Run("given a value, returns role=value", func(t *testing.T) {
+		assert.Equal(t, ` role="button"`, attr.Role("button"))
+	})
+}
+
+func TestAria(t *testing.T) {
+	t.Run("given a name and value, returns aria-name=value", func(t *testing.T) {
+		assert.Equal(t, ` aria-describedby="hat"`, attr.Aria("describedby", "hat"))
+	})
+
+	t.Run("does not repeat the aria- prefix and lowercases the name", func(t *testing.T) {
+		assert.Equal(t, ` aria-describedby="hat"`, attr.Aria("aria-describedBy", "hat"))
+	})
+}
+
+func TestAriaLabel(t *testing.T) {
+	t.Run("given a value, returns aria-label=value", func(t *testing.T) {
+		assert.Equal(t, ` aria-label="Close"`, attr.AriaLabel("Close"))
+	})
+}
+
+func TestAriaHidden(t *testing.T) {
+	t.Run("returns aria-hidden with a string boolean", func(t *testing.T) {
+		assert.Equal(t, ` aria-hidden="true"`, attr.AriaHidden(true))
+		assert.Equal(t, ` aria-hidden="false"`, attr.AriaHidden(false))
+	})
+}
+
+func TestAriaExpanded(t *testing.T) {
+	t.Run("returns aria-expanded with a string boolean", func(t *testing.T) {
+		assert.Equal(t, ` aria-expanded="true"`, attr.AriaExpanded(true))
+		assert.Equal(t, ` aria-expanded="false"`, attr.AriaExpanded(false))
+	})
+}
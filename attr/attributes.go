@@ -3,6 +3,7 @@
 package attr
 
 import (
+	"io"
 	"sort"
 	"strings"
 
@@ -14,25 +15,86 @@ func ID(v string) g.Node {
 	return g.Attr("id", v)
 }
 
-// Class returns an attribute with name "class" and the given value.
-func Class(v string) g.Node {
-	return g.Attr("class", v)
+// Class returns an attribute with name "class" and the given class names, separated by single spaces in argument order.
+// Empty class names are left out, and if all are empty, nothing is rendered.
+// See Classes for class names that are included conditionally.
+func Class(names ...string) g.Node {
+	var included []string
+	for _, name := range names {
+		if name = strings.TrimSpace(name); name != "" {
+			included = append(included, name)
+		}
+	}
+	if len(included) == 0 {
+		return g.Empty
+	}
+	return g.Attr("class", strings.Join(included, " "))
+}
+
+// Href returns an attribute with name "href" and the given URL.
+// URLs with schemes that can run scripts, like "javascript:", are replaced with "#", see gomponents.URLAttr.
+func Href(v string) g.Node {
+	return g.URLAttr("href", v)
+}
+
+// Src returns an attribute with name "src" and the given URL.
+// Like with Href, URLs with schemes other than "http", "https", and "mailto" are replaced, including "data:" URLs.
+func Src(v string) g.Node {
+	return g.URLAttr("src", v)
+}
+
+// Alt returns an attribute with name "alt" and the given value.
+func Alt(v string) g.Node {
+	return g.Attr("alt", v)
+}
+
+// Rel returns an attribute with name "rel" and the given value.
+func Rel(v string) g.Node {
+	return g.Attr("rel", v)
+}
+
+// Type returns an attribute with name "type" and the given value.
+func Type(v string) g.Node {
+	return g.Attr("type", v)
+}
+
+// Name returns an attribute with name "name" and the given value.
+func Name(v string) g.Node {
+	return g.Attr("name", v)
 }
 
 // Classes is a map of strings to booleans, which Renders to an attribute with name "class".
 // The attribute value is a sorted, space-separated string of all the map keys,
-// for which the corresponding map value is true.
+// for which the corresponding map value is true. If no map value is true, nothing is rendered.
 type Classes map[string]bool
 
 func (c Classes) Render() string {
+	var b strings.Builder
+	_ = c.RenderTo(&b)
+	return b.String()
+}
+
+// RenderTo satisfies gomponents.Renderer.
+func (c Classes) RenderTo(w io.Writer) error {
+	if a, ok := c.ResolveAttribute(); ok {
+		return g.Write(w, a)
+	}
+	return nil
+}
+
+// ResolveAttribute satisfies gomponents.AttributeResolver, so the classes are merged with other class attributes.
+func (c Classes) ResolveAttribute() (g.Attribute, bool) {
 	var included []string
 	for c, include := range c {
 		if include {
 			included = append(included, c)
 		}
 	}
+	if len(included) == 0 {
+		return g.Attribute{}, false
+	}
 	sort.Strings(included)
-	return g.Attr("class", strings.Join(included, " ")).Render()
+	return g.Attr("class", strings.Join(included, " ")).(g.Attribute), true
 }
 
 func (c Classes) Place() g.Placement {
@@ -43,3 +105,48 @@ func (c Classes) Place() g.Placement {
 func (c Classes) String() string {
 	return c.Render()
 }
+
+// Styles is a map of CSS property names to values, which Renders to an attribute with name "style".
+// The attribute value is a semicolon-separated string of "property:value" pairs, sorted by property name,
+// like "color:red;font-weight:bold". If the map is empty, nothing is rendered.
+type Styles map[string]string
+
+func (s Styles) Render() string {
+	var b strings.Builder
+	_ = s.RenderTo(&b)
+	return b.String()
+}
+
+// RenderTo satisfies gomponents.Renderer.
+func (s Styles) RenderTo(w io.Writer) error {
+	if a, ok := s.ResolveAttribute(); ok {
+		return g.Write(w, a)
+	}
+	return nil
+}
+
+// ResolveAttribute satisfies gomponents.AttributeResolver, so the styles are merged with other style attributes.
+func (s Styles) ResolveAttribute() (g.Attribute, bool) {
+	if len(s) == 0 {
+		return g.Attribute{}, false
+	}
+	properties := make([]string, 0, len(s))
+	for property := range s {
+		properties = append(properties, property)
+	}
+	sort.Strings(properties)
+	declarations := make([]string, 0, len(s))
+	for _, property := range properties {
+		declarations = append(declarations, property+":"+s[property])
+	}
+	return g.Attr("style", strings.Join(declarations, ";")).(g.Attribute), true
+}
+
+func (s Styles) Place() g.Placement {
+	return g.Inside
+}
+
+// String satisfies fmt.Stringer.
+func (s Styles) String() string {
+	return s.Render()
+}
@@ -18,6 +18,59 @@ func TestClass(t *testing.T) {
 	t.Run("given a value, returns class=value", func(t *testing.T) {
 		assert.Equal(t, ` class="hat"`, attr.Class("hat"))
 	})
+
+	t.Run("given several values, joins them with spaces and leaves out empty ones", func(t *testing.T) {
+		assert.Equal(t, ` class="hat partyhat"`, attr.Class("", "hat", " ", "partyhat ", ""))
+	})
+
+	t.Run("given only empty values, renders nothing", func(t *testing.T) {
+		assert.Equal(t, `<br />`, g.El("br", attr.Class("", " ")))
+		assert.Equal(t, `<div></div>`, g.El("div", attr.Class()))
+	})
+}
+
+func TestHref(t *testing.T) {
+	t.Run("given a value, returns href=value", func(t *testing.T) {
+		assert.Equal(t, ` href="hat"`, attr.Href("hat"))
+	})
+
+	t.Run("replaces javascript urls", func(t *testing.T) {
+		assert.Equal(t, ` href="#"`, attr.Href("javascript:alert('hat')"))
+	})
+}
+
+func TestSrc(t *testing.T) {
+	t.Run("given a value, returns src=value", func(t *testing.T) {
+		assert.Equal(t, ` src="hat"`, attr.Src("hat"))
+	})
+
+	t.Run("replaces data urls", func(t *testing.T) {
+		assert.Equal(t, ` src="#"`, attr.Src("data:text/html,hat"))
+	})
+}
+
+func TestAlt(t *testing.T) {
+	t.Run("given a value, returns alt=value", func(t *testing.T) {
+		assert.Equal(t, ` alt="hat"`, attr.Alt("hat"))
+	})
+}
+
+func TestRel(t *testing.T) {
+	t.Run("given a value, returns rel=value", func(t *testing.T) {
+		assert.Equal(t, ` rel="hat"`, attr.Rel("hat"))
+	})
+}
+
+func TestType(t *testing.T) {
+	t.Run("given a value, returns type=value", func(t *testing.T) {
+		assert.Equal(t, ` type="hat"`, attr.Type("hat"))
+	})
+}
+
+func TestName(t *testing.T) {
+	t.Run("given a value, returns name=value", func(t *testing.T) {
+		assert.Equal(t, ` name="hat"`, attr.Name("hat"))
+	})
 }
 
 func TestClasses(t *testing.T) {
@@ -30,9 +83,21 @@ func TestClasses(t *testing.T) {
 		})
 	})
 
+	t.Run("renders nothing if no value is true", func(t *testing.T) {
+		e := g.El("div", attr.Classes{"hat": false, "partyhat": false})
+		assert.Equal(t, `<div></div>`, e)
+	})
+
 	t.Run("renders as attribute in an element", func(t *testing.T) {
 		e := g.El("div", attr.Classes{"hat": true})
-		assert.Equal(t, `<div class="hat" />`, e)
+		assert.Equal(t, `<div class="hat"></div>`, e)
+	})
+
+	t.Run("merges with other class attributes", func(t *testing.T) {
+		e := g.El("div", g.Attr("class", "a"), attr.Class("b"), attr.Classes{"c": true, "d": false})
+		assert.Equal(t, `<div class="a b c"></div>`, e)
+		e = g.El("div", attr.Classes{"c": true}, attr.Classes{"d": true}, attr.Classes{"e": false})
+		assert.Equal(t, `<div class="c d"></div>`, e)
 	})
 
 	t.Run("also works with fmt", func(t *testing.T) {
@@ -42,3 +107,39 @@ func TestClasses(t *testing.T) {
 		}
 	})
 }
+
+func TestStyles(t *testing.T) {
+	t.Run("given a map, returns sorted declarations from the map", func(t *testing.T) {
+		assert.Equal(t, ` style="color:red;font-weight:bold;margin:0 auto"`, attr.Styles{
+			"margin":      "0 auto",
+			"color":       "red",
+			"font-weight": "bold",
+		})
+	})
+
+	t.Run("escapes values", func(t *testing.T) {
+		assert.Equal(t, ` style="font-family:&#34;Hat&#34;, serif"`, attr.Styles{"font-family": `"Hat", serif`})
+	})
+
+	t.Run("renders nothing for an empty map", func(t *testing.T) {
+		e := g.El("div", attr.Styles{})
+		assert.Equal(t, `<div></div>`, e)
+	})
+
+	t.Run("renders as attribute in an element", func(t *testing.T) {
+		e := g.El("div", attr.Styles{"color": "red"})
+		assert.Equal(t, `<div style="color:red"></div>`, e)
+	})
+
+	t.Run("merges with other style attributes", func(t *testing.T) {
+		e := g.El("div", g.Attr("style", "margin:0"), attr.Styles{"color": "red"}, attr.Styles{})
+		assert.Equal(t, `<div style="margin:0;color:red"></div>`, e)
+	})
+
+	t.Run("also works with fmt", func(t *testing.T) {
+		a := attr.Styles{"color": "red"}
+		if a.String() != ` style="color:red"` {
+			t.FailNow()
+		}
+	})
+}
@@ -0,0 +1,30 @@
+package attr
+
+import (
+	"sort"
+	"strings"
+
+	g "github.com/maragudk/gomponents"
+)
+
+// Data returns an attribute with name "data-" followed by the given name, and the given value.
+// The name is lowercased like the DOM does for data attributes, and a "data-" prefix is not repeated,
+// so both Data("Hat", "party") and Data("data-hat", "party") return the attribute data-hat="party".
+func Data(name, v string) g.Node {
+	name = strings.TrimPrefix(strings.ToLower(name), "data-")
+	return g.Attr("data-"+name, v)
+}
+
+// DataAttrs returns a data attribute for each map key and value, like Data does, sorted by name.
+func DataAttrs(m map[string]string) g.Node {
+	names := make([]string, 0, len(m))
+	for name := range m {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	attrs := make([]g.Node, 0, len(m))
+	for _, name := range names {
+		attrs = append(attrs, Data(name, m[name]))
+	}
+	return g.Group(attrs)
+}
@@ -0,0 +1,34 @@
+package attr_test
+
+import (
+	"testing"
+
+	g "github.com/maragudk/gomponents"
+	"github.com/maragudk/gomponents/assert"
+	"github.com/maragudk/gomponents/attr"
+)
+
+func TestData(t *testing.T) {
+	t.Run("given a name and value, returns data-name=value", func(t *testing.T) {
+		assert.Equal(t, ` data-hat="party"`, attr.Data("hat", "party"))
+	})
+
+	t.Run("does not repeat the data- prefix", func(t *testing.T) {
+		assert.Equal(t, ` data-hat="party"`, attr.Data("data-hat", "party"))
+	})
+
+	t.Run("lowercases the name", func(t *testing.T) {
+		assert.Equal(t, ` data-party-hat="yes"`, attr.Data("Party-Hat", "yes"))
+	})
+
+	t.Run("escapes the value", func(t *testing.T) {
+		assert.Equal(t, ` data-hat="&#34;party&#34;"`, attr.Data("hat", `"party"`))
+	})
+}
+
+func TestDataAttrs(t *testing.T) {
+	t.Run("given a map, returns sorted data attributes", func(t *testing.T) {
+		e := g.El("div", attr.DataAttrs(map[string]string{"size": "l", "hat": "party", "color": "red"}))
+		assert.Equal(t, `<div data-color="red" data-hat="party" data-size="l"></div>`, e)
+	})
+}
@@ -13,3 +13,51 @@ func Placeholder(v string) g.Node {
 func Required() g.Node {
 	return g.Attr("required")
 }
+
+// Value returns an attribute with name "value" and the given value.
+func Value(v string) g.Node {
+	return g.Attr("value", v)
+}
+
+// Action returns an attribute with name "action" and the given URL.
+// Like with Href, URLs with schemes that can run scripts are replaced with "#".
+func Action(v string) g.Node {
+	return g.URLAttr("action", v)
+}
+
+// Method returns an attribute with name "method" and the given value.
+func Method(v string) g.Node {
+	return g.Attr("method", v)
+}
+
+// Disabled returns an attribute with name "disabled".
+func Disabled() g.Node {
+	return g.Attr("disabled")
+}
+
+// Checked returns an attribute with name "checked".
+func Checked() g.Node {
+	return g.Attr("checked")
+}
+
+// ReadOnly returns an attribute with name "readonly".
+func ReadOnly() g.Node {
+	return g.Attr("readonly")
+}
+
+// CheckedIf returns an attribute with name "checked" if cond is true, and nothing otherwise.
+func CheckedIf(cond bool) g.Node {
+	return g.BoolAttr("checked", cond)
+}
+
+// SelectedIf returns an attribute with name "selected" if cond is true, and nothing otherwise,
+// like for the current option in a select element.
+func SelectedIf(cond bool) g.Node {
+	return g.BoolAttr("selected", cond)
+}
+
+// BoolAttrIf returns a name-only attribute with the given name if cond is true, and nothing otherwise.
+// It's the same as g.BoolAttr.
+func BoolAttrIf(name string, cond bool) g.Node {
+	return g.BoolAttr(name, cond)
+}
@@ -14,3 +14,38 @@ func TestForms(t *testing.T) {
 		assert.Equal(t, `<input placeholder="hat" required />`, e)
 	})
 }
+
+func TestFormValues(t *testing.T) {
+	t.Run("adds value, action, and method attributes", func(t *testing.T) {
+		assert.Equal(t, ` value="hat"`, attr.Value("hat"))
+		assert.Equal(t, ` action="/hats"`, attr.Action("/hats"))
+		assert.Equal(t, ` method="post"`, attr.Method("post"))
+	})
+
+	t.Run("replaces javascript urls in action", func(t *testing.T) {
+		assert.Equal(t, ` action="#"`, attr.Action("javascript:alert('hat')"))
+	})
+}
+
+func TestFormBooleans(t *testing.T) {
+	t.Run("adds disabled, checked, and readonly attributes", func(t *testing.T) {
+		e := g.El("input", attr.Disabled(), attr.Checked(), attr.ReadOnly())
+		assert.Equal(t, `<input disabled checked readonly />`, e)
+	})
+}
+
+func TestFormBooleansIf(t *testing.T) {
+	t.Run("adds selected and checked attributes if the condition holds", func(t *testing.T) {
+		var options []g.Node
+		for _, hat := range []string{"top", "party"} {
+			options = append(options, g.El("option", attr.SelectedIf(hat == "party"), g.Text(hat)))
+		}
+		assert.Equal(t, `<select><option>top</option><option selected>party</option></select>`, g.El("select", options...))
+		assert.Equal(t, `<input checked />`, g.El("input", attr.CheckedIf(true)))
+		assert.Equal(t, `<input />`, g.El("input", attr.CheckedIf(false)))
+	})
+
+	t.Run("adds any boolean attribute if the condition holds", func(t *testing.T) {
+		assert.Equal(t, `<input hidden />`, g.El("input", attr.BoolAttrIf("hidden", true), attr.BoolAttrIf("inert", false)))
+	})
+}
@@ -0,0 +1,91 @@
+package gomponents_test
+
+import (
+	"bytes"
+	"io"
+	"strconv"
+	"testing"
+
+	g "github.com/maragudk/gomponents"
+)
+
+// BenchmarkRender renders representative Node trees to a string, to an io.Writer, and to a reused buffer,
+// so changes to the render paths can be compared with go test -bench Render -benchmem.
+func BenchmarkRender(b *testing.B) {
+	fixtures := []struct {
+		name string
+		node g.Node
+	}{
+		{"small component", smallComponent()},
+		{"large table", largeTable(10000)},
+		{"deeply nested tree", nestedTree(500)},
+		{"attribute-heavy elements", attributeHeavy(100)},
+	}
+
+	for _, f := range fixtures {
+		b.Run(f.name+"/to string", func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				_ = f.node.Render()
+			}
+		})
+
+		b.Run(f.name+"/to writer", func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				_ = g.Write(io.Discard, f.node)
+			}
+		})
+
+		b.Run(f.name+"/to reused buffer", func(b *testing.B) {
+			b.ReportAllocs()
+			var buf bytes.Buffer
+			for i := 0; i < b.N; i++ {
+				buf.Reset()
+				_ = g.RenderBuffer(&buf, f.node)
+			}
+		})
+	}
+}
+
+func smallComponent() g.Node {
+	return g.El("div", g.Attr("class", "card"),
+		g.El("h2", g.Text("Partyhat")),
+		g.El("p", g.Text("A hat for parties & celebrations.")),
+		g.El("a", g.Attr("href", "/hats/partyhat"), g.Attr("class", "button"), g.Text("Buy")),
+	)
+}
+
+func largeTable(rows int) g.Node {
+	trs := make([]g.Node, 0, rows)
+	for i := 0; i < rows; i++ {
+		trs = append(trs, g.El("tr",
+			g.El("td", g.Text(strconv.Itoa(i))),
+			g.El("td", g.Textf("Hat %v", i)),
+			g.El("td", g.Attr("class", "price"), g.Text("10.00")),
+		))
+	}
+	return g.El("table", g.El("thead", g.El("tr", g.El("th", g.Text("ID")), g.El("th", g.Text("Name")), g.El("th", g.Text("Price")))),
+		g.El("tbody", g.Group(trs)))
+}
+
+func nestedTree(depth int) g.Node {
+	n := g.Text("hat")
+	for i := 0; i < depth; i++ {
+		n = g.El("div", g.Attr("class", "level"), n)
+	}
+	return n
+}
+
+func attributeHeavy(elements int) g.Node {
+	inputs := make([]g.Node, 0, elements)
+	for i := 0; i < elements; i++ {
+		id := "hat-" + strconv.Itoa(i)
+		inputs = append(inputs, g.El("input",
+			g.Attr("type", "text"), g.Attr("id", id), g.Attr("name", id), g.Attr("class", "input"),
+			g.Attr("class", "input-large"), g.Attr("placeholder", `Your "favourite" hat`), g.Attr("required"),
+			g.Attr("data-index", strconv.Itoa(i)), g.Attr("aria-label", "Hat & cap"), g.Attr("autocomplete", "off"),
+		))
+	}
+	return g.El("form", g.Group(inputs))
+}
@@ -0,0 +1,41 @@
+// Package components provides high-level components and helpers that are composed of low-level elements and attributes.
+package components
+
+import (
+	"io"
+
+	g "github.com/maragudk/gomponents"
+	"github.com/maragudk/gomponents/el"
+)
+
+// HTML5Props for HTML5.
+// Title is set no matter what, Language is optional.
+type HTML5Props struct {
+	Title    string
+	Language string
+	Head     []g.Node
+	Body     []g.Node
+}
+
+// HTML5 returns a complete HTML5 document, with the doctype followed by an html element
+// containing a head with the title and the given head nodes, and a body with the given body nodes.
+func HTML5(p HTML5Props) g.Node {
+	return html5(p, nil)
+}
+
+// html5 returns the HTML5 document, with the meta nodes first in the head.
+func html5(p HTML5Props, meta []g.Node) g.Node {
+	return el.Document(
+		el.HTML(g.If(p.Language != "", g.Attr("lang", p.Language)),
+			el.Head(g.Group(meta), el.Title(p.Title), g.Group(p.Head)),
+			el.Body(g.Group(p.Body)),
+		),
+	)
+}
+
+// WriteHTML5 writes a complete HTML5 document like HTML5 to w, with a charset meta element for UTF-8
+// and a viewport meta element for mobile devices first in the head, before the title and the given head nodes.
+// The document is written without a byte order mark, which the charset meta element makes unnecessary.
+func WriteHTML5(w io.Writer, p HTML5Props) error {
+	return g.Write(w, html5(p, []g.Node{Charset("utf-8"), Viewport("width=device-width, initial-scale=1")}))
+}
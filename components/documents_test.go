@@ -0,0 +1,53 @@
+package components_test
+
+import (
+	"strings"
+	"testing"
+
+	g "github.com/maragudk/gomponents"
+	"github.com/maragudk/gomponents/assert"
+	c "github.com/maragudk/gomponents/components"
+	"github.com/maragudk/gomponents/el"
+)
+
+func TestHTML5(t *testing.T) {
+	t.Run("returns an html5 document template", func(t *testing.T) {
+		e := c.HTML5(c.HTML5Props{
+			Title:    "Hat",
+			Language: "en",
+			Head:     []g.Node{el.Link(g.Attr("rel", "stylesheet"), g.Attr("href", "/hat.css"))},
+			Body:     []g.Node{el.Div()},
+		})
+
+		assert.Equal(t, `<!doctype html><html lang="en"><head><title>Hat</title><link rel="stylesheet" href="/hat.css" /></head><body><div></div></body></html>`, e)
+	})
+
+	t.Run("returns no language if empty", func(t *testing.T) {
+		e := c.HTML5(c.HTML5Props{
+			Title: "Hat",
+		})
+
+		assert.Equal(t, `<!doctype html><html><head><title>Hat</title></head><body></body></html>`, e)
+	})
+}
+
+func TestWriteHTML5(t *testing.T) {
+	t.Run("writes an html5 document with charset and viewport", func(t *testing.T) {
+		var b strings.Builder
+		err := c.WriteHTML5(&b, c.HTML5Props{
+			Title:    "Hat",
+			Language: "en",
+			Head:     []g.Node{el.Link(g.Attr("rel", "stylesheet"), g.Attr("href", "/hat.css"))},
+			Body:     []g.Node{el.Div()},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		expected := `<!doctype html><html lang="en"><head><meta charset="utf-8" />` +
+			`<meta name="viewport" content="width=device-width, initial-scale=1" /><title>Hat</title>` +
+			`<link rel="stylesheet" href="/hat.css" /></head><body><div></div></body></html>`
+		if b.String() != expected {
+			t.Fatalf("expected `%v` but got `%v`", expected, b.String())
+		}
+	})
+}
@@ -0,0 +1,40 @@
+package components
+
+import (
+	"strings"
+
+	g "github.com/maragudk/gomponents"
+	"github.com/maragudk/gomponents/attr"
+	"github.com/maragudk/gomponents/el"
+)
+
+// Field returns a div element with a label element with the given text, and the input,
+// where the label is associated with the input for accessibility.
+// If the input is an Element, like one created with el.Input or el.Select, the label has a "for" attribute
+// with the input id. The existing id of the input is used, and otherwise an id from its name, like "field-hat"
+// for the name "hat", is added to it. Set an id on inputs with the same name, like radio buttons, to keep them unique.
+// Other Nodes and Elements without an id or name are put inside the label element instead,
+// which also associates it with the label.
+func Field(label string, input g.Node) g.Node {
+	e, ok := input.(g.Element)
+	if !ok {
+		return el.Div(g.El("label", g.Text(label), input))
+	}
+
+	var id string
+	if a, ok := e.Attribute("id"); ok {
+		id, _ = a.Value()
+	}
+	if id == "" {
+		var name string
+		if a, ok := e.Attribute("name"); ok {
+			name, _ = a.Value()
+		}
+		if name = strings.Join(strings.Fields(name), "-"); name == "" {
+			return el.Div(g.El("label", g.Text(label), e))
+		}
+		id = "field-" + name
+		e = e.With(attr.ID(id))
+	}
+	return el.Div(el.Label(id, g.Text(label)), e)
+}
@@ -0,0 +1,35 @@
+package components_test
+
+import (
+	"testing"
+
+	g "github.com/maragudk/gomponents"
+	"github.com/maragudk/gomponents/assert"
+	c "github.com/maragudk/gomponents/components"
+	"github.com/maragudk/gomponents/el"
+)
+
+func TestField(t *testing.T) {
+	t.Run("associates the label with the existing input id", func(t *testing.T) {
+		n := c.Field("Hat", el.Input("text", "hat", g.Attr("id", "hat")))
+		assert.Equal(t, `<div><label for="hat">Hat</label><input type="text" name="hat" id="hat" /></div>`, n)
+	})
+
+	t.Run("adds an id from the name to the input without one", func(t *testing.T) {
+		n := c.Field("Hat", el.Input("text", "hat"))
+		expected := `<div><label for="field-hat">Hat</label><input type="text" name="hat" id="field-hat" /></div>`
+		assert.Equal(t, expected, n)
+		assert.Equal(t, expected, n)
+		assert.Equal(t, `<div><label for="field-party-hat">Hat</label><select name="party hat" id="field-party-hat"></select></div>`,
+			c.Field("Hat", el.Select("party hat")))
+	})
+
+	t.Run("puts the input without id and name inside the label", func(t *testing.T) {
+		assert.Equal(t, `<div><label>Hat<input type="text" /></label></div>`, c.Field("Hat", g.El("input", g.Attr("type", "text"))))
+	})
+
+	t.Run("puts other nodes inside the label", func(t *testing.T) {
+		n := c.Field("Hat", g.Group([]g.Node{el.Input("checkbox", "hat")}))
+		assert.Equal(t, `<div><label>Hat<input type="checkbox" name="hat" /></label></div>`, n)
+	})
+}
@@ -0,0 +1,29 @@
+package components
+
+import (
+	g "github.com/maragudk/gomponents"
+	"github.com/maragudk/gomponents/attr"
+	"github.com/maragudk/gomponents/el"
+)
+
+// Charset returns a meta element with the given character encoding, like "utf-8".
+func Charset(charset string) g.Node {
+	return el.Meta(g.Attr("charset", charset))
+}
+
+// Viewport returns a meta element with name "viewport" and the given content,
+// like "width=device-width, initial-scale=1".
+func Viewport(content string) g.Node {
+	return el.Meta(attr.Name("viewport"), g.Attr("content", content))
+}
+
+// Description returns a meta element with name "description" and the given description of the page,
+// which search engines can show in their results.
+func Description(description string) g.Node {
+	return el.Meta(attr.Name("description"), g.Attr("content", description))
+}
+
+// StyleSheet returns a link element to the stylesheet at href.
+func StyleSheet(href string) g.Node {
+	return el.Link(attr.Rel("stylesheet"), attr.Href(href))
+}
@@ -0,0 +1,32 @@
+package components_test
+
+import (
+	"testing"
+
+	"github.com/maragudk/gomponents/assert"
+	c "github.com/maragudk/gomponents/components"
+)
+
+func TestCharset(t *testing.T) {
+	t.Run("returns a meta element with the charset", func(t *testing.T) {
+		assert.Equal(t, `<meta charset="utf-8" />`, c.Charset("utf-8"))
+	})
+}
+
+func TestViewport(t *testing.T) {
+	t.Run("returns a meta element with the viewport content", func(t *testing.T) {
+		assert.Equal(t, `<meta name="viewport" content="width=device-width, initial-scale=1" />`, c.Viewport("width=device-width, initial-scale=1"))
+	})
+}
+
+func TestDescription(t *testing.T) {
+	t.Run("returns a meta element with the escaped description", func(t *testing.T) {
+		assert.Equal(t, `<meta name="description" content="Hats &amp; more" />`, c.Description("Hats & more"))
+	})
+}
+
+func TestStyleSheet(t *testing.T) {
+	t.Run("returns a link element to the stylesheet", func(t *testing.T) {
+		assert.Equal(t, `<link rel="stylesheet" href="/hat.css" />`, c.StyleSheet("/hat.css"))
+	})
+}
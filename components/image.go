@@ -0,0 +1,59 @@
+package components
+
+import (
+	g "github.com/maragudk/gomponents"
+	"github.com/maragudk/gomponents/el"
+)
+
+// ImageSource for Image, with the image candidates in SrcSet, like "/hat.webp 1x, /hat@2x.webp 2x",
+// and optionally the conditions for using them.
+type ImageSource struct {
+	SrcSet string
+	// Media query for the source, like "(min-width: 800px)".
+	Media string
+	// Type of the images, like "image/webp".
+	Type string
+	// Sizes of the image for different viewport widths, like "(min-width: 800px) 50vw, 100vw".
+	Sizes string
+}
+
+// ImageProps for Image.
+// Src and Alt are set no matter what, and Attrs are added to the img element, like a class or loading="lazy".
+type ImageProps struct {
+	Src     string
+	Alt     string
+	Sources []ImageSource
+	Attrs   []g.Node
+}
+
+// Image returns a responsive image, as a picture element with a source element for each of the sources,
+// followed by an img element with the default Src as the fallback.
+// Without sources, it's just the img element, and with a single source without Media and Type,
+// it's the img element with the srcset and sizes of the source, because a picture element isn't needed then.
+func Image(p ImageProps) g.Node {
+	if len(p.Sources) == 0 {
+		return el.Img(p.Src, p.Alt, p.Attrs...)
+	}
+	if len(p.Sources) == 1 && p.Sources[0].Media == "" && p.Sources[0].Type == "" {
+		s := p.Sources[0]
+		return el.Img(p.Src, p.Alt, srcSet(s), g.Group(p.Attrs))
+	}
+
+	var sources []g.Node
+	for _, s := range p.Sources {
+		sources = append(sources, el.Source(
+			g.If(s.Type != "", g.Attr("type", s.Type)),
+			g.If(s.Media != "", g.Attr("media", s.Media)),
+			srcSet(s),
+		))
+	}
+	return el.Picture(g.Group(sources), el.Img(p.Src, p.Alt, p.Attrs...))
+}
+
+// srcSet returns the srcset and sizes attributes of s.
+func srcSet(s ImageSource) g.Node {
+	return g.Group([]g.Node{
+		g.Attr("srcset", s.SrcSet),
+		g.If(s.Sizes != "", g.Attr("sizes", s.Sizes)),
+	})
+}
@@ -0,0 +1,39 @@
+package components_test
+
+import (
+	"testing"
+
+	g "github.com/maragudk/gomponents"
+	"github.com/maragudk/gomponents/assert"
+	c "github.com/maragudk/gomponents/components"
+)
+
+func TestImage(t *testing.T) {
+	t.Run("returns a picture with sources and a fallback img", func(t *testing.T) {
+		n := c.Image(c.ImageProps{
+			Src: "/hat.jpg",
+			Alt: "A party hat",
+			Sources: []c.ImageSource{
+				{SrcSet: "/hat.avif", Type: "image/avif"},
+				{SrcSet: "/hat-wide.jpg 1x, /hat-wide@2x.jpg 2x", Media: "(min-width: 800px)", Sizes: "50vw"},
+			},
+			Attrs: []g.Node{g.Attr("loading", "lazy")},
+		})
+		assert.Equal(t, `<picture><source type="image/avif" srcset="/hat.avif" />`+
+			`<source media="(min-width: 800px)" srcset="/hat-wide.jpg 1x, /hat-wide@2x.jpg 2x" sizes="50vw" />`+
+			`<img src="/hat.jpg" alt="A party hat" loading="lazy" /></picture>`, n)
+	})
+
+	t.Run("returns a plain img without sources", func(t *testing.T) {
+		assert.Equal(t, `<img src="/hat.jpg" alt="A party hat" />`, c.Image(c.ImageProps{Src: "/hat.jpg", Alt: "A party hat"}))
+	})
+
+	t.Run("replaces javascript urls in src", func(t *testing.T) {
+		assert.Equal(t, `<img src="#" alt="" />`, c.Image(c.ImageProps{Src: "javascript:alert(1)"}))
+	})
+
+	t.Run("returns an img with srcset for a single unconditional source", func(t *testing.T) {
+		n := c.Image(c.ImageProps{Src: "/hat.jpg", Alt: "", Sources: []c.ImageSource{{SrcSet: "/hat.jpg 1x, /hat@2x.jpg 2x"}}})
+		assert.Equal(t, `<img src="/hat.jpg" alt="" srcset="/hat.jpg 1x, /hat@2x.jpg 2x" />`, n)
+	})
+}
@@ -0,0 +1,20 @@
+package components
+
+import (
+	"encoding/json"
+
+	g "github.com/maragudk/gomponents"
+	"github.com/maragudk/gomponents/el"
+)
+
+// JSONScript returns a script element with type "application/json" and the given id, containing v as JSON,
+// for passing data to client-side JavaScript, which can read it with
+// JSON.parse(document.getElementById(id).textContent).
+// The characters "<", ">", and "&" are escaped like "\u003c", so the JSON cannot close the script element early.
+func JSONScript(id string, v interface{}) (g.Node, error) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	return el.Script(g.Attr("type", "application/json"), g.Attr("id", id), g.Raw(string(b))), nil
+}
@@ -0,0 +1,32 @@
+package components_test
+
+import (
+	"testing"
+
+	"github.com/maragudk/gomponents/assert"
+	c "github.com/maragudk/gomponents/components"
+)
+
+func TestJSONScript(t *testing.T) {
+	t.Run("returns a script element with the value as json", func(t *testing.T) {
+		n, err := c.JSONScript("hats", map[string]interface{}{"name": "Partyhat", "sizes": []int{1, 2}})
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, `<script type="application/json" id="hats">{"name":"Partyhat","sizes":[1,2]}</script>`, n)
+	})
+
+	t.Run("escapes characters that could close the script element", func(t *testing.T) {
+		n, err := c.JSONScript("hats", "</script><!-- & more")
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, `<script type="application/json" id="hats">"\u003c/script\u003e\u003c!-- \u0026 more"</script>`, n)
+	})
+
+	t.Run("errors if the value cannot be marshalled", func(t *testing.T) {
+		if _, err := c.JSONScript("hats", func() {}); err == nil {
+			t.FailNow()
+		}
+	})
+}
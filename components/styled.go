@@ -0,0 +1,35 @@
+package components
+
+import (
+	"fmt"
+	"hash/fnv"
+	"strings"
+
+	g "github.com/maragudk/gomponents"
+	"github.com/maragudk/gomponents/el"
+)
+
+// Styled returns an element with the given name and children, and a class generated from css, like "s-1a2b3c4d",
+// for component-scoped styles. A style element with the css for the class is required with gomponents.RequireAsset,
+// so render the page with gomponents.HoistAssets to get it in the head, once for all elements with the same css.
+// The css is a list of declarations like "color: red; padding: 1rem". If it contains "&", it's a stylesheet instead,
+// where "&" is replaced with the class selector, like "& { color: red } &:hover { color: blue }".
+func Styled(name, css string, children ...g.Node) g.Node {
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(css))
+	class := fmt.Sprintf("s-%x", h.Sum32())
+
+	stylesheet := "." + class + "{" + css + "}"
+	if strings.Contains(css, "&") {
+		stylesheet = strings.ReplaceAll(css, "&", "."+class)
+	}
+	// Keep the css from ending the style element
+	stylesheet = strings.ReplaceAll(stylesheet, "</", `<\/`)
+
+	return g.El(name, g.Attr("class", class), g.RequireAsset(el.Style(g.Raw(stylesheet))), g.Group(children))
+}
+
+// StyledDiv returns a div element with a class for the css, like Styled.
+func StyledDiv(css string, children ...g.Node) g.Node {
+	return Styled("div", css, children...)
+}
@@ -0,0 +1,72 @@
+package components_test
+
+import (
+	"strings"
+	"testing"
+
+	g "github.com/maragudk/gomponents"
+	"github.com/maragudk/gomponents/assert"
+	c "github.com/maragudk/gomponents/components"
+	"github.com/maragudk/gomponents/el"
+)
+
+func TestStyled(t *testing.T) {
+	t.Run("renders the element with a generated class, and hoists the style once", func(t *testing.T) {
+		n := g.HoistAssets(el.HTML(el.Head(),
+			el.Body(c.StyledDiv("color: red", g.Text("Partyhat")), c.StyledDiv("color: red", g.Text("Turtlehat")), c.Styled("p", "color: blue")),
+		))
+		s := n.Render()
+		if strings.Count(s, "<style>") != 2 || !strings.Contains(s, "{color: red}</style>") || !strings.Contains(s, "{color: blue}</style>") {
+			t.Fatalf("unexpected styles in %v", s)
+		}
+		if !strings.Contains(s, `</style></head>`) {
+			t.Fatalf("styles not in head in %v", s)
+		}
+	})
+
+	t.Run("hoists the style from a slot of a layout", func(t *testing.T) {
+		layout := g.HoistAssets(el.HTML(el.Head(), el.Body(g.Slot("content", nil))))
+		s := g.Layout(layout, map[string]g.Node{"content": c.StyledDiv("color: red")}).Render()
+		if !strings.Contains(s, "{color: red}</style></head>") {
+			t.Fatalf("style not in head in %v", s)
+		}
+	})
+
+	t.Run("hoists the style from a memoized node on every render", func(t *testing.T) {
+		body := g.Memo(c.StyledDiv("color: green"))
+		cached := g.MemoKey("styled-test", func() g.Node { return c.StyledDiv("color: purple") })
+		for i := 0; i < 2; i++ {
+			s := g.HoistAssets(el.HTML(el.Head(), el.Body(body, cached))).Render()
+			if !strings.Contains(s, "{color: green}</style>") || !strings.Contains(s, "{color: purple}</style></head>") {
+				t.Fatalf("styles not in head in render %v: %v", i+1, s)
+			}
+		}
+	})
+
+	t.Run("adds the nonce to the hoisted style", func(t *testing.T) {
+		s := g.WithNonce("hat123", g.HoistAssets(el.HTML(el.Head(), el.Body(c.StyledDiv("color: orange"))))).Render()
+		if !strings.Contains(s, `<head><style nonce="hat123">`) || !strings.Contains(s, "{color: orange}</style></head>") {
+			t.Fatalf("style without nonce in %v", s)
+		}
+	})
+
+	t.Run("uses the same class for the same css", func(t *testing.T) {
+		a, b := c.StyledDiv("color: red").Render(), c.StyledDiv("color: red").Render()
+		if a != b || a == c.StyledDiv("color: blue").Render() || !strings.HasPrefix(a, `<div class="s-`) {
+			t.Fatalf("unexpected classes %v and %v", a, b)
+		}
+	})
+
+	t.Run("replaces & in the css with the class selector", func(t *testing.T) {
+		s := g.HoistAssets(c.Styled("a", "&:hover { color: red }")).Render()
+		class := strings.TrimSuffix(strings.TrimPrefix(s[strings.Index(s, "<a"):], `<a class="`), `"></a>`)
+		assert.Equal(t, `<style>.`+class+`:hover { color: red }</style><a class="`+class+`"></a>`, g.Raw(s))
+	})
+
+	t.Run("keeps the css from ending the style element", func(t *testing.T) {
+		s := g.HoistAssets(c.StyledDiv("color: red</style><script>")).Render()
+		if strings.Contains(s, "</style><script>") {
+			t.Fatalf("css not escaped in %v", s)
+		}
+	})
+}
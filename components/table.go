@@ -0,0 +1,90 @@
+package components
+
+import (
+	"io"
+
+	g "github.com/maragudk/gomponents"
+	"github.com/maragudk/gomponents/el"
+)
+
+// Table returns a table element with the header cells in a "thead" row, and each of the rows in "tbody",
+// so the table is structured correctly by construction. Header cells are wrapped in "th" elements,
+// and row cells in "td" elements, unless they already are one, for example to set a colspan.
+// If there are no header cells, there is no "thead". See TableBuilder for building the table step by step.
+func Table(header []g.Node, rows [][]g.Node) g.Node {
+	b := &TableBuilder{header: header, rows: rows}
+	return b.Build()
+}
+
+// TableBuilder builds a table element like Table, with a header and rows added with its methods:
+//
+//	b := &components.TableBuilder{}
+//	b.Header(g.Text("Name"), g.Text("Price"))
+//	for _, hat := range hats {
+//		b.Row(g.Text(hat.Name), g.Text(hat.Price))
+//	}
+//
+// It's a Node itself, which renders the table, so it can be used directly as a child.
+type TableBuilder struct {
+	attrs  []g.Node
+	header []g.Node
+	rows   [][]g.Node
+}
+
+// Attrs adds attributes to the table element, like a class.
+func (b *TableBuilder) Attrs(attrs ...g.Node) *TableBuilder {
+	b.attrs = append(b.attrs, attrs...)
+	return b
+}
+
+// Header sets the header cells.
+func (b *TableBuilder) Header(cells ...g.Node) *TableBuilder {
+	b.header = cells
+	return b
+}
+
+// Row adds a row with the given cells.
+func (b *TableBuilder) Row(cells ...g.Node) *TableBuilder {
+	b.rows = append(b.rows, cells)
+	return b
+}
+
+// Build the table element.
+func (b *TableBuilder) Build() g.Element {
+	var head g.Node
+	if len(b.header) > 0 {
+		head = el.THead(el.Tr(cells(b.header, "th", el.Th)...))
+	}
+	rows := make([]g.Node, 0, len(b.rows))
+	for _, row := range b.rows {
+		rows = append(rows, el.Tr(cells(row, "td", el.Td)...))
+	}
+	return el.Table(g.Group(b.attrs), head, el.TBody(rows...))
+}
+
+func (b *TableBuilder) Render() string {
+	return b.Build().Render()
+}
+
+// RenderTo satisfies gomponents.Renderer.
+func (b *TableBuilder) RenderTo(w io.Writer) error {
+	return b.Build().RenderTo(w)
+}
+
+// String satisfies fmt.Stringer.
+func (b *TableBuilder) String() string {
+	return b.Render()
+}
+
+// cells wraps each of the nodes in an element created with wrap, unless it's already an element with the given name.
+func cells(nodes []g.Node, name string, wrap func(...g.Node) g.Element) []g.Node {
+	result := make([]g.Node, 0, len(nodes))
+	for _, n := range nodes {
+		if e, ok := n.(g.Element); ok && e.Name() == name {
+			result = append(result, e)
+			continue
+		}
+		result = append(result, wrap(n))
+	}
+	return result
+}
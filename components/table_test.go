@@ -0,0 +1,40 @@
+package components_test
+
+import (
+	"testing"
+
+	g "github.com/maragudk/gomponents"
+	"github.com/maragudk/gomponents/assert"
+	c "github.com/maragudk/gomponents/components"
+	"github.com/maragudk/gomponents/el"
+)
+
+func TestTable(t *testing.T) {
+	t.Run("returns a table with header and rows", func(t *testing.T) {
+		n := c.Table(
+			[]g.Node{g.Text("Name"), g.Text("Price")},
+			[][]g.Node{
+				{g.Text("Partyhat"), g.Text("10")},
+				{el.Td(g.Attr("colspan", "2"), g.Text("Sold out"))},
+			},
+		)
+		assert.Equal(t, `<table><thead><tr><th>Name</th><th>Price</th></tr></thead>`+
+			`<tbody><tr><td>Partyhat</td><td>10</td></tr><tr><td colspan="2">Sold out</td></tr></tbody></table>`, n)
+	})
+
+	t.Run("returns a table without thead without header cells", func(t *testing.T) {
+		assert.Equal(t, `<table><tbody><tr><td>hat</td></tr></tbody></table>`, c.Table(nil, [][]g.Node{{g.Text("hat")}}))
+	})
+}
+
+func TestTableBuilder(t *testing.T) {
+	t.Run("builds a table step by step", func(t *testing.T) {
+		b := &c.TableBuilder{}
+		b.Attrs(g.Attr("class", "hats")).Header(g.Text("Name"))
+		for _, hat := range []string{"Partyhat", "Turtlehat"} {
+			b.Row(g.Text(hat))
+		}
+		assert.Equal(t, `<div><table class="hats"><thead><tr><th>Name</th></tr></thead>`+
+			`<tbody><tr><td>Partyhat</td></tr><tr><td>Turtlehat</td></tr></tbody></table></div>`, el.Div(b))
+	})
+}
@@ -0,0 +1,57 @@
+package gomponents_test
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"sync"
+	"testing"
+
+	g "github.com/maragudk/gomponents"
+)
+
+func TestConcurrentRendering(t *testing.T) {
+	t.Run("renders a shared tree concurrently with the same result", func(t *testing.T) {
+		var items []g.Node
+		for i := 0; i < 20; i++ {
+			items = append(items, g.El("li", g.Attr("class", "hat"), g.Attr("class", "item"), g.Textf("Hat %v", i)))
+		}
+		n := g.El("html",
+			g.El("head", g.Memo(g.El("title", g.Text("Hats & more")))),
+			g.El("body",
+				g.Layout(g.El("main", g.Slot("content", nil)), map[string]g.Node{"content": g.El("ul", g.Group(items))}),
+				g.MemoKey("concurrent-footer", func() g.Node { return g.El("footer", g.Text("Bye")) }),
+				g.Lazy(func() g.Node { return g.El("p", hatFromContext{}) }),
+				g.Flush(),
+			),
+		)
+		expected := n.Render()
+
+		var wg sync.WaitGroup
+		for i := 0; i < 50; i++ {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				var s string
+				switch i % 4 {
+				case 0:
+					s = n.Render()
+				case 1:
+					var b strings.Builder
+					_ = g.Write(&b, n)
+					s = b.String()
+				case 2:
+					var b bytes.Buffer
+					_ = g.RenderContext(context.Background(), &b, n)
+					s = b.String()
+				case 3:
+					s = string(g.RenderBytes(n))
+				}
+				if s != expected {
+					t.Errorf("unexpected output %v", s)
+				}
+			}(i)
+		}
+		wg.Wait()
+	})
+}
@@ -0,0 +1,58 @@
+package gomponents
+
+import (
+	"context"
+	"io"
+)
+
+// WithNonce returns n with a "nonce" attribute with the given value added to every "script" and "style"
+// element in it, for use with a Content-Security-Policy that only allows inline scripts and styles with that nonce.
+// Elements that already have a nonce attribute are left as they are.
+// The attribute is added when rendering, so it's also added to elements from functions like Lazy,
+// and to assets hoisted with HoistAssets.
+func WithNonce(nonce string, n Node) Node {
+	return nonced{nonce: Attr("nonce", nonce), n: n}
+}
+
+type nonced struct {
+	nonce Node
+	n     Node
+}
+
+func (n nonced) Render() string {
+	return renderString(n)
+}
+
+// RenderTo satisfies Renderer.
+func (n nonced) RenderTo(w io.Writer) error {
+	return n.RenderContext(writerContext(w), w)
+}
+
+// RenderContext satisfies ContextNode.
+func (n nonced) RenderContext(ctx context.Context, w io.Writer) error {
+	return RenderContext(context.WithValue(ctx, nonceKey{}, n.nonce), w, n.n)
+}
+
+func (n nonced) Place() Placement {
+	return placement(n.n)
+}
+
+// String satisfies fmt.Stringer.
+func (n nonced) String() string {
+	return n.Render()
+}
+
+type nonceKey struct{}
+
+// addNonce returns the children of the script or style element e with the nonce from ctx first,
+// if there is one and e doesn't have a nonce already.
+func addNonce(ctx context.Context, e Element, children []Node) []Node {
+	nonce, ok := ctx.Value(nonceKey{}).(Node)
+	if !ok {
+		return children
+	}
+	if _, ok := e.Attribute("nonce"); ok {
+		return children
+	}
+	return append([]Node{nonce}, children...)
+}
@@ -0,0 +1,56 @@
+package gomponents_test
+
+import (
+	"strings"
+	"testing"
+
+	g "github.com/maragudk/gomponents"
+	"github.com/maragudk/gomponents/assert"
+)
+
+func TestWithNonce(t *testing.T) {
+	t.Run("adds the nonce to script and style elements", func(t *testing.T) {
+		n := g.WithNonce("hat123", g.El("html",
+			g.El("head", g.El("style", g.Text("p {}")), g.El("script", g.Attr("src", "/hat.js"))),
+			g.El("body", g.Group([]g.Node{g.El("div", g.El("script", g.Text("wear('hat')")))})),
+		))
+		assert.Equal(t, `<html><head><style nonce="hat123">p {}</style><script nonce="hat123" src="/hat.js"></script></head>`+
+			`<body><div><script nonce="hat123">wear('hat')</script></div></body></html>`, n)
+	})
+
+	t.Run("does not add the nonce if it's already there", func(t *testing.T) {
+		n := g.WithNonce("hat123", g.El("script", g.Attr("nonce", "partyhat")))
+		assert.Equal(t, `<script nonce="partyhat"></script>`, n)
+	})
+
+	t.Run("does not change other nodes", func(t *testing.T) {
+		n := g.WithNonce("hat123", g.Group([]g.Node{g.Text("hat"), g.El("p", g.Attr("class", "hat"))}))
+		assert.Equal(t, `hat<p class="hat"></p>`, n)
+	})
+
+	t.Run("adds the nonce to hoisted styles and elements from functions", func(t *testing.T) {
+		n := g.WithNonce("hat123", g.HoistAssets(g.El("html", g.El("head"), g.El("body",
+			g.RequireAsset(g.El("style", g.Text("p {}"))),
+			g.InHead(g.El("script", g.Attr("src", "/hat.js"))),
+			g.Lazy(func() g.Node { return g.El("script", g.Text("wear('hat')")) }),
+		))))
+		assert.Equal(t, `<html><head><style nonce="hat123">p {}</style><script nonce="hat123" src="/hat.js"></script></head>`+
+			`<body><script nonce="hat123">wear('hat')</script></body></html>`, n)
+	})
+
+	t.Run("adds the nonce when pretty-printing", func(t *testing.T) {
+		var b strings.Builder
+		if err := g.RenderIndented(&b, g.WithNonce("hat123", g.El("div", g.El("p"), g.El("script"))), "  "); err != nil {
+			t.Fatal(err)
+		}
+		if b.String() != "<div>\n  <p></p>\n  <script nonce=\"hat123\"></script>\n</div>\n" {
+			t.Fatalf("unexpected output %q", b.String())
+		}
+	})
+
+	t.Run("does not change the original node", func(t *testing.T) {
+		original := g.El("div", g.El("script"))
+		_ = g.WithNonce("hat123", original)
+		assert.Equal(t, `<div><script></script></div>`, original)
+	})
+}
@@ -0,0 +1,77 @@
+package gomponents
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+// debugSnippetLength is the maximum number of characters of text shown by Debug.
+const debugSnippetLength = 60
+
+// Debug returns an indented dump of the Node tree starting at n, for troubleshooting how it was built.
+// Elements and Groups are shown like s-expressions, with their children indented on the following lines:
+//
+//	(div
+//	  @class="hat"
+//	  (group
+//	    (span
+//	      "Party & hat")))
+//
+// Attributes are prefixed with "@", text is quoted unescaped, and Nodes created with Raw or other
+// functions are shown with "raw" and their output. Long text is shortened. Nil Nodes are skipped.
+func Debug(n Node) string {
+	var b strings.Builder
+	debugNode(&b, n, 0)
+	return b.String()
+}
+
+func debugNode(b *strings.Builder, n Node, depth int) {
+	if n == nil {
+		return
+	}
+	if depth > 0 {
+		b.WriteString("\n")
+	}
+	b.WriteString(strings.Repeat("  ", depth))
+
+	switch n := n.(type) {
+	case Element:
+		b.WriteString("(" + n.name)
+		for _, c := range n.children {
+			debugNode(b, c, depth+1)
+		}
+		b.WriteString(")")
+	case group:
+		b.WriteString("(group")
+		for _, c := range n.children {
+			debugNode(b, c, depth+1)
+		}
+		b.WriteString(")")
+	case lazy:
+		b.WriteString("(lazy")
+		debugNode(b, n(), depth+1)
+		b.WriteString(")")
+	case Attribute:
+		b.WriteString("@" + n.name)
+		if v, ok := n.Value(); ok {
+			b.WriteString("=" + debugSnippet(v))
+		}
+	case text:
+		b.WriteString(debugSnippet(string(n)))
+	case empty:
+		b.WriteString("(empty)")
+	case NodeFunc:
+		b.WriteString("(raw " + debugSnippet(n.Render()) + ")")
+	default:
+		fmt.Fprintf(b, "(%T %v)", n, debugSnippet(n.Render()))
+	}
+}
+
+// debugSnippet returns s quoted, and shortened to debugSnippetLength characters.
+func debugSnippet(s string) string {
+	if utf8.RuneCountInString(s) <= debugSnippetLength {
+		return fmt.Sprintf("%q", s)
+	}
+	return fmt.Sprintf("%q…", string([]rune(s)[:debugSnippetLength]))
+}
@@ -0,0 +1,40 @@
+package gomponents_test
+
+import (
+	"strings"
+	"testing"
+
+	g "github.com/maragudk/gomponents"
+)
+
+func TestDebug(t *testing.T) {
+	t.Run("dumps the node tree", func(t *testing.T) {
+		n := g.El("div", g.Attr("class", "hat"), g.Attr("hidden"), nil,
+			g.Group([]g.Node{g.El("span", g.Text("Party & hat")), g.Raw("<b>raw</b>")}),
+			g.Lazy(func() g.Node { return g.El("br") }),
+			g.If(false, g.Text("hat")),
+			outsider{},
+		)
+		expected := `(div
+  @class="hat"
+  @hidden
+  (group
+    (span
+      "Party & hat")
+    (raw "<b>raw</b>"))
+  (lazy
+    (br))
+  (empty)
+  (gomponents_test.outsider "outsider"))`
+		if s := g.Debug(n); s != expected {
+			t.Fatalf("expected\n%v\ngot\n%v", expected, s)
+		}
+	})
+
+	t.Run("shortens long text", func(t *testing.T) {
+		s := g.Debug(g.Text(strings.Repeat("hat", 30)))
+		if s != `"`+strings.Repeat("hat", 20)+`"…` {
+			t.Fatalf("unexpected dump %v", s)
+		}
+	})
+}
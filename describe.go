@@ -0,0 +1,62 @@
+package gomponents
+
+// ComponentDescription describes the structure of an Element, see Describe.
+type ComponentDescription struct {
+	// Name of the element, or empty if the described Node isn't an Element.
+	Name string
+
+	// Attributes of the element, in the order they were first given.
+	Attributes []AttributeDescription
+
+	// Children are the descriptions of the child elements, in order.
+	Children []ComponentDescription
+}
+
+// AttributeDescription describes an attribute of an Element, see Describe.
+type AttributeDescription struct {
+	Name string
+
+	// HasValue is whether the attribute has a value, so false for name-only attributes like "required".
+	HasValue bool
+}
+
+// Describe the structure of the Node tree starting at n, for tooling like generating component documentation.
+// Only Elements and their Attributes are described, with Groups and Lazy Nodes descended into transparently.
+// Attributes with the same name are described once, with a value if any of them has one.
+// If n isn't an Element, the returned description has no name or attributes, but the elements in n as Children.
+func Describe(n Node) ComponentDescription {
+	var d ComponentDescription
+	if e, ok := n.(Element); ok {
+		d.Name = e.name
+		describeChildren(&d, e.children)
+		return d
+	}
+	describeChildren(&d, []Node{n})
+	return d
+}
+
+func describeChildren(d *ComponentDescription, children []Node) {
+	for _, c := range children {
+		switch c := c.(type) {
+		case Element:
+			d.Children = append(d.Children, Describe(c))
+		case group:
+			describeChildren(d, c.children)
+		case lazy:
+			describeChildren(d, []Node{c()})
+		case Attribute:
+			_, hasValue := c.Value()
+			describeAttribute(d, AttributeDescription{Name: c.name, HasValue: hasValue})
+		}
+	}
+}
+
+func describeAttribute(d *ComponentDescription, a AttributeDescription) {
+	for i := range d.Attributes {
+		if d.Attributes[i].Name == a.Name {
+			d.Attributes[i].HasValue = d.Attributes[i].HasValue || a.HasValue
+			return
+		}
+	}
+	d.Attributes = append(d.Attributes, a)
+}
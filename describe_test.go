@@ -0,0 +1,37 @@
+package gomponents_test
+
+import (
+	"reflect"
+	"testing"
+
+	g "github.com/maragudk/gomponents"
+)
+
+func TestDescribe(t *testing.T) {
+	t.Run("describes elements, attributes, and child elements", func(t *testing.T) {
+		n := g.El("form", g.Attr("action", "/hats"), g.Attr("novalidate"), g.Text("Hats"),
+			g.Group([]g.Node{g.El("input", g.Attr("required"), g.Attr("class", "a"), g.Attr("class", "b"))}),
+			g.Lazy(func() g.Node { return g.El("button", g.Attr("disabled"), g.Attr("disabled", "")) }),
+			nil,
+		)
+		expected := g.ComponentDescription{
+			Name:       "form",
+			Attributes: []g.AttributeDescription{{Name: "action", HasValue: true}, {Name: "novalidate"}},
+			Children: []g.ComponentDescription{
+				{Name: "input", Attributes: []g.AttributeDescription{{Name: "required"}, {Name: "class", HasValue: true}}},
+				{Name: "button", Attributes: []g.AttributeDescription{{Name: "disabled", HasValue: true}}},
+			},
+		}
+		if d := g.Describe(n); !reflect.DeepEqual(d, expected) {
+			t.Fatalf("expected %+v, got %+v", expected, d)
+		}
+	})
+
+	t.Run("describes the elements in a group without a name", func(t *testing.T) {
+		d := g.Describe(g.Group([]g.Node{g.El("span"), g.Text("hat"), g.El("br")}))
+		expected := g.ComponentDescription{Children: []g.ComponentDescription{{Name: "span"}, {Name: "br"}}}
+		if !reflect.DeepEqual(d, expected) {
+			t.Fatalf("expected %+v, got %+v", expected, d)
+		}
+	})
+}
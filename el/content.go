@@ -4,22 +4,62 @@ import (
 	g "github.com/maragudk/gomponents"
 )
 
-func Div(children ...g.Node) g.NodeFunc {
+func Div(children ...g.Node) g.Element {
 	return g.El("div", children...)
 }
 
-func Ol(children ...g.Node) g.NodeFunc {
+func Ol(children ...g.Node) g.Element {
 	return g.El("ol", children...)
 }
 
-func Ul(children ...g.Node) g.NodeFunc {
+func Ul(children ...g.Node) g.Element {
 	return g.El("ul", children...)
 }
 
-func Li(children ...g.Node) g.NodeFunc {
+func Li(children ...g.Node) g.Element {
 	return g.El("li", children...)
 }
 
-func P(children ...g.Node) g.NodeFunc {
+func P(children ...g.Node) g.Element {
 	return g.El("p", children...)
 }
+
+// Blockquote returns an element with name "blockquote" and the given children.
+func Blockquote(children ...g.Node) g.Element {
+	return g.El("blockquote", children...)
+}
+
+// Dd returns an element with name "dd" and the given children.
+func Dd(children ...g.Node) g.Element {
+	return g.El("dd", children...)
+}
+
+// Dl returns an element with name "dl" and the given children.
+func Dl(children ...g.Node) g.Element {
+	return g.El("dl", children...)
+}
+
+// Dt returns an element with name "dt" and the given children.
+func Dt(children ...g.Node) g.Element {
+	return g.El("dt", children...)
+}
+
+// FigCaption returns an element with name "figcaption" and the given children.
+func FigCaption(children ...g.Node) g.Element {
+	return g.El("figcaption", children...)
+}
+
+// Figure returns an element with name "figure" and the given children.
+func Figure(children ...g.Node) g.Element {
+	return g.El("figure", children...)
+}
+
+// Hr returns an element with name "hr" and the given children.
+func Hr(children ...g.Node) g.Element {
+	return g.El("hr", children...)
+}
+
+// Pre returns an element with name "pre" and the given children.
+func Pre(children ...g.Node) g.Element {
+	return g.El("pre", children...)
+}
@@ -10,19 +10,19 @@ import (
 
 func TestDiv(t *testing.T) {
 	t.Run("returns a div element", func(t *testing.T) {
-		assert.Equal(t, `<div><span /></div>`, el.Div(el.Span()))
+		assert.Equal(t, `<div><span></span></div>`, el.Div(el.Span()))
 	})
 }
 
 func TestOl(t *testing.T) {
 	t.Run("returns an ol element", func(t *testing.T) {
-		assert.Equal(t, `<ol><li /></ol>`, el.Ol(el.Li()))
+		assert.Equal(t, `<ol><li></li></ol>`, el.Ol(el.Li()))
 	})
 }
 
 func TestUl(t *testing.T) {
 	t.Run("returns a ul element", func(t *testing.T) {
-		assert.Equal(t, `<ul><li /></ul>`, el.Ul(el.Li()))
+		assert.Equal(t, `<ul><li></li></ul>`, el.Ul(el.Li()))
 	})
 }
 
@@ -37,3 +37,51 @@ func TestP(t *testing.T) {
 		assert.Equal(t, `<p>hat</p>`, el.P(g.Text("hat")))
 	})
 }
+
+func TestBlockquote(t *testing.T) {
+	t.Run("returns a blockquote element", func(t *testing.T) {
+		assert.Equal(t, `<blockquote></blockquote>`, el.Blockquote())
+	})
+}
+
+func TestDd(t *testing.T) {
+	t.Run("returns a dd element", func(t *testing.T) {
+		assert.Equal(t, `<dd></dd>`, el.Dd())
+	})
+}
+
+func TestDl(t *testing.T) {
+	t.Run("returns a dl element", func(t *testing.T) {
+		assert.Equal(t, `<dl></dl>`, el.Dl())
+	})
+}
+
+func TestDt(t *testing.T) {
+	t.Run("returns a dt element", func(t *testing.T) {
+		assert.Equal(t, `<dt></dt>`, el.Dt())
+	})
+}
+
+func TestFigCaption(t *testing.T) {
+	t.Run("returns a figcaption element", func(t *testing.T) {
+		assert.Equal(t, `<figcaption></figcaption>`, el.FigCaption())
+	})
+}
+
+func TestFigure(t *testing.T) {
+	t.Run("returns a figure element", func(t *testing.T) {
+		assert.Equal(t, `<figure></figure>`, el.Figure())
+	})
+}
+
+func TestHr(t *testing.T) {
+	t.Run("returns an hr element", func(t *testing.T) {
+		assert.Equal(t, `<hr />`, el.Hr())
+	})
+}
+
+func TestPre(t *testing.T) {
+	t.Run("returns a pre element", func(t *testing.T) {
+		assert.Equal(t, `<pre></pre>`, el.Pre())
+	})
+}
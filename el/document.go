@@ -3,55 +3,66 @@
 package el
 
 import (
-	"strings"
-
 	g "github.com/maragudk/gomponents"
 )
 
 // Document returns an special kind of Node that prefixes its children with the string "<!doctype html>".
-func Document(children ...g.Node) g.NodeFunc {
-	return func() string {
-		var b strings.Builder
-		b.WriteString("<!doctype html>")
-		for _, c := range children {
-			b.WriteString(c.Render())
-		}
-		return b.String()
-	}
+func Document(children ...g.Node) g.Node {
+	return g.Group(append([]g.Node{g.Raw("<!doctype html>")}, children...))
 }
 
 // HTML returns an element with name "html" and the given children.
-func HTML(children ...g.Node) g.NodeFunc {
+func HTML(children ...g.Node) g.Element {
 	return g.El("html", children...)
 }
 
 // Head returns an element with name "head" and the given children.
-func Head(children ...g.Node) g.NodeFunc {
+func Head(children ...g.Node) g.Element {
 	return g.El("head", children...)
 }
 
 // Body returns an element with name "body" and the given children.
-func Body(children ...g.Node) g.NodeFunc {
+func Body(children ...g.Node) g.Element {
 	return g.El("body", children...)
 }
 
 // Title returns an element with name "title" and a single Text child.
-func Title(title string) g.NodeFunc {
+func Title(title string) g.Element {
 	return g.El("title", g.Text(title))
 }
 
-func Meta(children ...g.Node) g.NodeFunc {
+func Meta(children ...g.Node) g.Element {
 	return g.El("meta", children...)
 }
 
-func Link(children ...g.Node) g.NodeFunc {
+func Link(children ...g.Node) g.Element {
 	return g.El("link", children...)
 }
 
-func Style(children ...g.Node) g.NodeFunc {
+func Style(children ...g.Node) g.Element {
 	return g.El("style", children...)
 }
 
-func Base(children ...g.Node) g.NodeFunc {
+func Base(children ...g.Node) g.Element {
 	return g.El("base", children...)
 }
+
+// NoScript returns an element with name "noscript" and the given children.
+func NoScript(children ...g.Node) g.Element {
+	return g.El("noscript", children...)
+}
+
+// Script returns an element with name "script" and the given children.
+func Script(children ...g.Node) g.Element {
+	return g.El("script", children...)
+}
+
+// Slot returns an element with name "slot" and the given children.
+func Slot(children ...g.Node) g.Element {
+	return g.El("slot", children...)
+}
+
+// Template returns an element with name "template" and the given children.
+func Template(children ...g.Node) g.Element {
+	return g.El("template", children...)
+}
@@ -1,6 +1,7 @@
 package el_test
 
 import (
+	"strings"
 	"testing"
 
 	g "github.com/maragudk/gomponents"
@@ -10,25 +11,35 @@ import (
 
 func TestDocument(t *testing.T) {
 	t.Run("returns doctype and children", func(t *testing.T) {
-		assert.Equal(t, `<!doctype html><html />`, el.Document(g.El("html")))
+		assert.Equal(t, `<!doctype html><html></html>`, el.Document(g.El("html")))
+	})
+
+	t.Run("writes doctype and children to a writer", func(t *testing.T) {
+		var b strings.Builder
+		if err := g.Write(&b, el.Document(g.El("html"))); err != nil {
+			t.FailNow()
+		}
+		if b.String() != `<!doctype html><html></html>` {
+			t.FailNow()
+		}
 	})
 }
 
 func TestHTML(t *testing.T) {
 	t.Run("returns an html element", func(t *testing.T) {
-		assert.Equal(t, "<html><div /><span /></html>", el.HTML(g.El("div"), g.El("span")))
+		assert.Equal(t, "<html><div></div><span></span></html>", el.HTML(g.El("div"), g.El("span")))
 	})
 }
 
 func TestHead(t *testing.T) {
 	t.Run("returns a head element", func(t *testing.T) {
-		assert.Equal(t, "<head><title /><link /></head>", el.Head(g.El("title"), g.El("link")))
+		assert.Equal(t, "<head><title></title><link /></head>", el.Head(g.El("title"), g.El("link")))
 	})
 }
 
 func TestBody(t *testing.T) {
 	t.Run("returns a body element", func(t *testing.T) {
-		assert.Equal(t, "<body><div /><span /></body>", el.Body(g.El("div"), g.El("span")))
+		assert.Equal(t, "<body><div></div><span></span></body>", el.Body(g.El("div"), g.El("span")))
 	})
 }
 
@@ -52,7 +63,7 @@ func TestLink(t *testing.T) {
 
 func TestStyle(t *testing.T) {
 	t.Run("returns a style element", func(t *testing.T) {
-		assert.Equal(t, `<style type="text/css" />`, el.Style(g.Attr("type", "text/css")))
+		assert.Equal(t, `<style type="text/css"></style>`, el.Style(g.Attr("type", "text/css")))
 	})
 }
 
@@ -61,3 +72,31 @@ func TestBase(t *testing.T) {
 		assert.Equal(t, `<base href="/hat/" />`, el.Base(g.Attr("href", "/hat/")))
 	})
 }
+
+func TestNoScript(t *testing.T) {
+	t.Run("returns a noscript element", func(t *testing.T) {
+		assert.Equal(t, `<noscript></noscript>`, el.NoScript())
+	})
+}
+
+func TestScript(t *testing.T) {
+	t.Run("returns a script element", func(t *testing.T) {
+		assert.Equal(t, `<script></script>`, el.Script())
+	})
+
+	t.Run("does not escape text content", func(t *testing.T) {
+		assert.Equal(t, `<script>if (a < b && c) {}</script>`, el.Script(g.Text("if (a < b && c) {}")))
+	})
+}
+
+func TestSlot(t *testing.T) {
+	t.Run("returns a slot element", func(t *testing.T) {
+		assert.Equal(t, `<slot></slot>`, el.Slot())
+	})
+}
+
+func TestTemplate(t *testing.T) {
+	t.Run("returns a template element", func(t *testing.T) {
+		assert.Equal(t, `<template></template>`, el.Template())
+	})
+}
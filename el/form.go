@@ -7,34 +7,35 @@ import (
 )
 
 // Button returns an element with name "button" and the given children.
-func Button(children ...g.Node) g.NodeFunc {
+func Button(children ...g.Node) g.Element {
 	return g.El("button", children...)
 }
 
 // Form returns an element with name "form", the given action and method attributes, and the given children.
-func Form(action, method string, children ...g.Node) g.NodeFunc {
-	return g.El("form", g.Attr("action", action), g.Attr("method", method), g.Group(children))
+// Like with attr.Action, URLs with schemes that can run scripts are replaced with "#", see gomponents.URLAttr.
+func Form(action, method string, children ...g.Node) g.Element {
+	return g.El("form", g.URLAttr("action", action), g.Attr("method", method), g.Group(children))
 }
 
 // Input returns an element with name "input", the given type and name attributes, and the given children.
 // Note that "type" is a keyword in Go, so the parameter is called typ.
-func Input(typ, name string, children ...g.Node) g.NodeFunc {
+func Input(typ, name string, children ...g.Node) g.Element {
 	return g.El("input", g.Attr("type", typ), g.Attr("name", name), g.Group(children))
 }
 
 // Label returns an element with name "label", the given for attribute, and the given children.
 // Note that "for" is a keyword in Go, so the parameter is called forr.
-func Label(forr string, children ...g.Node) g.NodeFunc {
+func Label(forr string, children ...g.Node) g.Element {
 	return g.El("label", g.Attr("for", forr), g.Group(children))
 }
 
 // Option returns an element with name "option", the given text content and value attribute, and the given children.
-func Option(text, value string, children ...g.Node) g.NodeFunc {
+func Option(text, value string, children ...g.Node) g.Element {
 	return g.El("option", g.Attr("value", value), g.Text(text), g.Group(children))
 }
 
 // Progress returns an element with name "progress", the given value and max attributes, and the given children.
-func Progress(value, max float64, children ...g.Node) g.NodeFunc {
+func Progress(value, max float64, children ...g.Node) g.Element {
 	return g.El("progress",
 		g.Attr("value", fmt.Sprintf("%v", value)),
 		g.Attr("max", fmt.Sprintf("%v", max)),
@@ -42,11 +43,41 @@ func Progress(value, max float64, children ...g.Node) g.NodeFunc {
 }
 
 // Select returns an element with name "select", the given name attribute, and the given children.
-func Select(name string, children ...g.Node) g.NodeFunc {
+func Select(name string, children ...g.Node) g.Element {
 	return g.El("select", g.Attr("name", name), g.Group(children))
 }
 
 // Textarea returns an element with name "textarea", the given name attribute, and the given children.
-func Textarea(name string, children ...g.Node) g.NodeFunc {
+func Textarea(name string, children ...g.Node) g.Element {
 	return g.El("textarea", g.Attr("name", name), g.Group(children))
 }
+
+// DataList returns an element with name "datalist" and the given children.
+func DataList(children ...g.Node) g.Element {
+	return g.El("datalist", children...)
+}
+
+// FieldSet returns an element with name "fieldset" and the given children.
+func FieldSet(children ...g.Node) g.Element {
+	return g.El("fieldset", children...)
+}
+
+// Legend returns an element with name "legend" and the given children.
+func Legend(children ...g.Node) g.Element {
+	return g.El("legend", children...)
+}
+
+// Meter returns an element with name "meter" and the given children.
+func Meter(children ...g.Node) g.Element {
+	return g.El("meter", children...)
+}
+
+// OptGroup returns an element with name "optgroup" and the given children.
+func OptGroup(children ...g.Node) g.Element {
+	return g.El("optgroup", children...)
+}
+
+// Output returns an element with name "output" and the given children.
+func Output(children ...g.Node) g.Element {
+	return g.El("output", children...)
+}
@@ -10,13 +10,17 @@ import (
 
 func TestButton(t *testing.T) {
 	t.Run("returns a button element", func(t *testing.T) {
-		assert.Equal(t, `<button />`, el.Button())
+		assert.Equal(t, `<button></button>`, el.Button())
 	})
 }
 
 func TestForm(t *testing.T) {
 	t.Run("returns a form element with action and method attributes", func(t *testing.T) {
-		assert.Equal(t, `<form action="/" method="post" />`, el.Form("/", "post"))
+		assert.Equal(t, `<form action="/" method="post"></form>`, el.Form("/", "post"))
+	})
+
+	t.Run("replaces javascript urls in action", func(t *testing.T) {
+		assert.Equal(t, `<form action="#" method="post"></form>`, el.Form("javascript:alert(1)", "post"))
 	})
 }
 
@@ -40,7 +44,7 @@ func TestOption(t *testing.T) {
 
 func TestProgress(t *testing.T) {
 	t.Run("returns a progress element with attributes value and max", func(t *testing.T) {
-		assert.Equal(t, `<progress value="5.5" max="10" />`, el.Progress(5.5, 10))
+		assert.Equal(t, `<progress value="5.5" max="10"></progress>`, el.Progress(5.5, 10))
 	})
 }
 
@@ -53,6 +57,42 @@ func TestSelect(t *testing.T) {
 
 func TestTextarea(t *testing.T) {
 	t.Run("returns a textarea element with attribute name", func(t *testing.T) {
-		assert.Equal(t, `<textarea name="hat" />`, el.Textarea("hat"))
+		assert.Equal(t, `<textarea name="hat"></textarea>`, el.Textarea("hat"))
+	})
+}
+
+func TestDataList(t *testing.T) {
+	t.Run("returns a datalist element", func(t *testing.T) {
+		assert.Equal(t, `<datalist></datalist>`, el.DataList())
+	})
+}
+
+func TestFieldSet(t *testing.T) {
+	t.Run("returns a fieldset element", func(t *testing.T) {
+		assert.Equal(t, `<fieldset></fieldset>`, el.FieldSet())
+	})
+}
+
+func TestLegend(t *testing.T) {
+	t.Run("returns a legend element", func(t *testing.T) {
+		assert.Equal(t, `<legend></legend>`, el.Legend())
+	})
+}
+
+func TestMeter(t *testing.T) {
+	t.Run("returns a meter element", func(t *testing.T) {
+		assert.Equal(t, `<meter></meter>`, el.Meter())
+	})
+}
+
+func TestOptGroup(t *testing.T) {
+	t.Run("returns an optgroup element", func(t *testing.T) {
+		assert.Equal(t, `<optgroup></optgroup>`, el.OptGroup())
+	})
+}
+
+func TestOutput(t *testing.T) {
+	t.Run("returns an output element", func(t *testing.T) {
+		assert.Equal(t, `<output></output>`, el.Output())
 	})
 }
@@ -4,26 +4,151 @@ import (
 	g "github.com/maragudk/gomponents"
 )
 
-func Span(children ...g.Node) g.NodeFunc {
+func Span(children ...g.Node) g.Element {
 	return g.El("span", children...)
 }
 
-func A(href string, children ...g.Node) g.NodeFunc {
-	return g.El("a", g.Attr("href", href), g.Group(children))
+func A(href string, children ...g.Node) g.Element {
+	return g.El("a", g.URLAttr("href", href), g.Group(children))
 }
 
-func B(text string, children ...g.Node) g.NodeFunc {
+func B(text string, children ...g.Node) g.Element {
 	return g.El("b", g.Text(text), g.Group(children))
 }
 
-func Strong(text string, children ...g.Node) g.NodeFunc {
+func Strong(text string, children ...g.Node) g.Element {
 	return g.El("strong", g.Text(text), g.Group(children))
 }
 
-func I(text string, children ...g.Node) g.NodeFunc {
+func I(text string, children ...g.Node) g.Element {
 	return g.El("i", g.Text(text), g.Group(children))
 }
 
-func Em(text string, children ...g.Node) g.NodeFunc {
+func Em(text string, children ...g.Node) g.Element {
 	return g.El("em", g.Text(text), g.Group(children))
 }
+
+// Abbr returns an element with name "abbr" and the given children.
+func Abbr(children ...g.Node) g.Element {
+	return g.El("abbr", children...)
+}
+
+// Bdi returns an element with name "bdi" and the given children.
+func Bdi(children ...g.Node) g.Element {
+	return g.El("bdi", children...)
+}
+
+// Bdo returns an element with name "bdo" and the given children.
+func Bdo(children ...g.Node) g.Element {
+	return g.El("bdo", children...)
+}
+
+// Br returns an element with name "br" and the given children.
+func Br(children ...g.Node) g.Element {
+	return g.El("br", children...)
+}
+
+// Cite returns an element with name "cite" and the given children.
+func Cite(children ...g.Node) g.Element {
+	return g.El("cite", children...)
+}
+
+// Code returns an element with name "code" and the given children.
+func Code(children ...g.Node) g.Element {
+	return g.El("code", children...)
+}
+
+// Data returns an element with name "data" and the given children.
+func Data(children ...g.Node) g.Element {
+	return g.El("data", children...)
+}
+
+// Del returns an element with name "del" and the given children.
+func Del(children ...g.Node) g.Element {
+	return g.El("del", children...)
+}
+
+// Dfn returns an element with name "dfn" and the given children.
+func Dfn(children ...g.Node) g.Element {
+	return g.El("dfn", children...)
+}
+
+// Ins returns an element with name "ins" and the given children.
+func Ins(children ...g.Node) g.Element {
+	return g.El("ins", children...)
+}
+
+// Kbd returns an element with name "kbd" and the given children.
+func Kbd(children ...g.Node) g.Element {
+	return g.El("kbd", children...)
+}
+
+// Mark returns an element with name "mark" and the given children.
+func Mark(children ...g.Node) g.Element {
+	return g.El("mark", children...)
+}
+
+// Q returns an element with name "q" and the given children.
+func Q(children ...g.Node) g.Element {
+	return g.El("q", children...)
+}
+
+// Rp returns an element with name "rp" and the given children.
+func Rp(children ...g.Node) g.Element {
+	return g.El("rp", children...)
+}
+
+// Rt returns an element with name "rt" and the given children.
+func Rt(children ...g.Node) g.Element {
+	return g.El("rt", children...)
+}
+
+// Ruby returns an element with name "ruby" and the given children.
+func Ruby(children ...g.Node) g.Element {
+	return g.El("ruby", children...)
+}
+
+// S returns an element with name "s" and the given children.
+func S(children ...g.Node) g.Element {
+	return g.El("s", children...)
+}
+
+// Samp returns an element with name "samp" and the given children.
+func Samp(children ...g.Node) g.Element {
+	return g.El("samp", children...)
+}
+
+// Small returns an element with name "small" and the given children.
+func Small(children ...g.Node) g.Element {
+	return g.El("small", children...)
+}
+
+// Sub returns an element with name "sub" and the given children.
+func Sub(children ...g.Node) g.Element {
+	return g.El("sub", children...)
+}
+
+// Sup returns an element with name "sup" and the given children.
+func Sup(children ...g.Node) g.Element {
+	return g.El("sup", children...)
+}
+
+// Time returns an element with name "time" and the given children.
+func Time(children ...g.Node) g.Element {
+	return g.El("time", children...)
+}
+
+// U returns an element with name "u" and the given children.
+func U(children ...g.Node) g.Element {
+	return g.El("u", children...)
+}
+
+// Var returns an element with name "var" and the given children.
+func Var(children ...g.Node) g.Element {
+	return g.El("var", children...)
+}
+
+// Wbr returns an element with name "wbr" and the given children.
+func Wbr(children ...g.Node) g.Element {
+	return g.El("wbr", children...)
+}
@@ -18,6 +18,11 @@ func TestA(t *testing.T) {
 	t.Run("returns an a element with a href attribute", func(t *testing.T) {
 		assert.Equal(t, `<a href="#">hat</a>`, el.A("#", g.Text("hat")))
 	})
+
+	t.Run("replaces javascript urls in href", func(t *testing.T) {
+		assert.Equal(t, `<a href="#">hat</a>`, el.A("javascript:alert(1)", g.Text("hat")))
+		assert.Equal(t, `<a href="mailto:hat@example.com">hat</a>`, el.A("mailto:hat@example.com", g.Text("hat")))
+	})
 }
 
 func TestB(t *testing.T) {
@@ -43,3 +48,153 @@ func TestEm(t *testing.T) {
 		assert.Equal(t, `<em id="text">hat</em>`, el.Em("hat", g.Attr("id", "text")))
 	})
 }
+
+func TestAbbr(t *testing.T) {
+	t.Run("returns an abbr element", func(t *testing.T) {
+		assert.Equal(t, `<abbr></abbr>`, el.Abbr())
+	})
+}
+
+func TestBdi(t *testing.T) {
+	t.Run("returns a bdi element", func(t *testing.T) {
+		assert.Equal(t, `<bdi></bdi>`, el.Bdi())
+	})
+}
+
+func TestBdo(t *testing.T) {
+	t.Run("returns a bdo element", func(t *testing.T) {
+		assert.Equal(t, `<bdo></bdo>`, el.Bdo())
+	})
+}
+
+func TestBr(t *testing.T) {
+	t.Run("returns a br element", func(t *testing.T) {
+		assert.Equal(t, `<br />`, el.Br())
+	})
+}
+
+func TestCite(t *testing.T) {
+	t.Run("returns a cite element", func(t *testing.T) {
+		assert.Equal(t, `<cite></cite>`, el.Cite())
+	})
+}
+
+func TestCode(t *testing.T) {
+	t.Run("returns a code element", func(t *testing.T) {
+		assert.Equal(t, `<code></code>`, el.Code())
+	})
+}
+
+func TestData(t *testing.T) {
+	t.Run("returns a data element", func(t *testing.T) {
+		assert.Equal(t, `<data></data>`, el.Data())
+	})
+}
+
+func TestDel(t *testing.T) {
+	t.Run("returns a del element", func(t *testing.T) {
+		assert.Equal(t, `<del></del>`, el.Del())
+	})
+}
+
+func TestDfn(t *testing.T) {
+	t.Run("returns a dfn element", func(t *testing.T) {
+		assert.Equal(t, `<dfn></dfn>`, el.Dfn())
+	})
+}
+
+func TestIns(t *testing.T) {
+	t.Run("returns an ins element", func(t *testing.T) {
+		assert.Equal(t, `<ins></ins>`, el.Ins())
+	})
+}
+
+func TestKbd(t *testing.T) {
+	t.Run("returns a kbd element", func(t *testing.T) {
+		assert.Equal(t, `<kbd></kbd>`, el.Kbd())
+	})
+}
+
+func TestMark(t *testing.T) {
+	t.Run("returns a mark element", func(t *testing.T) {
+		assert.Equal(t, `<mark></mark>`, el.Mark())
+	})
+}
+
+func TestQ(t *testing.T) {
+	t.Run("returns a q element", func(t *testing.T) {
+		assert.Equal(t, `<q></q>`, el.Q())
+	})
+}
+
+func TestRp(t *testing.T) {
+	t.Run("returns an rp element", func(t *testing.T) {
+		assert.Equal(t, `<rp></rp>`, el.Rp())
+	})
+}
+
+func TestRt(t *testing.T) {
+	t.Run("returns an rt element", func(t *testing.T) {
+		assert.Equal(t, `<rt></rt>`, el.Rt())
+	})
+}
+
+func TestRuby(t *testing.T) {
+	t.Run("returns a ruby element", func(t *testing.T) {
+		assert.Equal(t, `<ruby></ruby>`, el.Ruby())
+	})
+}
+
+func TestS(t *testing.T) {
+	t.Run("returns an s element", func(t *testing.T) {
+		assert.Equal(t, `<s></s>`, el.S())
+	})
+}
+
+func TestSamp(t *testing.T) {
+	t.Run("returns a samp element", func(t *testing.T) {
+		assert.Equal(t, `<samp></samp>`, el.Samp())
+	})
+}
+
+func TestSmall(t *testing.T) {
+	t.Run("returns a small element", func(t *testing.T) {
+		assert.Equal(t, `<small></small>`, el.Small())
+	})
+}
+
+func TestSub(t *testing.T) {
+	t.Run("returns a sub element", func(t *testing.T) {
+		assert.Equal(t, `<sub></sub>`, el.Sub())
+	})
+}
+
+func TestSup(t *testing.T) {
+	t.Run("returns a sup element", func(t *testing.T) {
+		assert.Equal(t, `<sup></sup>`, el.Sup())
+	})
+}
+
+func TestTime(t *testing.T) {
+	t.Run("returns a time element", func(t *testing.T) {
+		assert.Equal(t, `<time></time>`, el.Time())
+	})
+}
+
+func TestU(t *testing.T) {
+	t.Run("returns a u element", func(t *testing.T) {
+		assert.Equal(t, `<u></u>`, el.U())
+	})
+}
+
+func TestVar(t *testing.T) {
+	t.Run("returns a var element", func(t *testing.T) {
+		assert.Equal(t, `<var></var>`, el.Var())
+	})
+}
+
+func TestWbr(t *testing.T) {
+	t.Run("returns a wbr element", func(t *testing.T) {
+		assert.Equal(t, `<wbr />`, el.Wbr())
+	})
+}
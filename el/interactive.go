@@ -0,0 +1,25 @@
+package el
+
+import (
+	g "github.com/maragudk/gomponents"
+)
+
+// Details returns an element with name "details" and the given children.
+func Details(children ...g.Node) g.Element {
+	return g.El("details", children...)
+}
+
+// Dialog returns an element with name "dialog" and the given children.
+func Dialog(children ...g.Node) g.Element {
+	return g.El("dialog", children...)
+}
+
+// Menu returns an element with name "menu" and the given children.
+func Menu(children ...g.Node) g.Element {
+	return g.El("menu", children...)
+}
+
+// Summary returns an element with name "summary" and the given children.
+func Summary(children ...g.Node) g.Element {
+	return g.El("summary", children...)
+}
@@ -0,0 +1,32 @@
+package el_test
+
+import (
+	"testing"
+
+	"github.com/maragudk/gomponents/assert"
+	"github.com/maragudk/gomponents/el"
+)
+
+func TestDetails(t *testing.T) {
+	t.Run("returns a details element", func(t *testing.T) {
+		assert.Equal(t, `<details></details>`, el.Details())
+	})
+}
+
+func TestDialog(t *testing.T) {
+	t.Run("returns a dialog element", func(t *testing.T) {
+		assert.Equal(t, `<dialog></dialog>`, el.Dialog())
+	})
+}
+
+func TestMenu(t *testing.T) {
+	t.Run("returns a menu element", func(t *testing.T) {
+		assert.Equal(t, `<menu></menu>`, el.Menu())
+	})
+}
+
+func TestSummary(t *testing.T) {
+	t.Run("returns a summary element", func(t *testing.T) {
+		assert.Equal(t, `<summary></summary>`, el.Summary())
+	})
+}
@@ -4,6 +4,66 @@ import (
 	g "github.com/maragudk/gomponents"
 )
 
-func Img(src, alt string, children ...g.Node) g.NodeFunc {
-	return g.El("img", g.Attr("src", src), g.Attr("alt", alt), g.Group(children))
+func Img(src, alt string, children ...g.Node) g.Element {
+	return g.El("img", g.URLAttr("src", src), g.Attr("alt", alt), g.Group(children))
+}
+
+// Area returns an element with name "area" and the given children.
+func Area(children ...g.Node) g.Element {
+	return g.El("area", children...)
+}
+
+// Audio returns an element with name "audio" and the given children.
+func Audio(children ...g.Node) g.Element {
+	return g.El("audio", children...)
+}
+
+// Canvas returns an element with name "canvas" and the given children.
+func Canvas(children ...g.Node) g.Element {
+	return g.El("canvas", children...)
+}
+
+// Embed returns an element with name "embed" and the given children.
+func Embed(children ...g.Node) g.Element {
+	return g.El("embed", children...)
+}
+
+// IFrame returns an element with name "iframe" and the given children.
+func IFrame(children ...g.Node) g.Element {
+	return g.El("iframe", children...)
+}
+
+// Map returns an element with name "map" and the given children.
+func Map(children ...g.Node) g.Element {
+	return g.El("map", children...)
+}
+
+// Object returns an element with name "object" and the given children.
+func Object(children ...g.Node) g.Element {
+	return g.El("object", children...)
+}
+
+// Param returns an element with name "param" and the given children.
+func Param(children ...g.Node) g.Element {
+	return g.El("param", children...)
+}
+
+// Picture returns an element with name "picture" and the given children.
+func Picture(children ...g.Node) g.Element {
+	return g.El("picture", children...)
+}
+
+// Source returns an element with name "source" and the given children.
+func Source(children ...g.Node) g.Element {
+	return g.El("source", children...)
+}
+
+// Track returns an element with name "track" and the given children.
+func Track(children ...g.Node) g.Element {
+	return g.El("track", children...)
+}
+
+// Video returns an element with name "video" and the given children.
+func Video(children ...g.Node) g.Element {
+	return g.El("video", children...)
 }
@@ -12,4 +12,80 @@ func TestImg(t *testing.T) {
 	t.Run("returns an img element with href and alt attributes", func(t *testing.T) {
 		assert.Equal(t, `<img src="hat.png" alt="hat" id="image" />`, el.Img("hat.png", "hat", g.Attr("id", "image")))
 	})
+
+	t.Run("replaces javascript urls in src", func(t *testing.T) {
+		assert.Equal(t, `<img src="#" alt="hat" />`, el.Img("javascript:alert(1)", "hat"))
+	})
+}
+
+func TestArea(t *testing.T) {
+	t.Run("returns an area element", func(t *testing.T) {
+		assert.Equal(t, `<area />`, el.Area())
+	})
+}
+
+func TestAudio(t *testing.T) {
+	t.Run("returns an audio element", func(t *testing.T) {
+		assert.Equal(t, `<audio></audio>`, el.Audio())
+	})
+}
+
+func TestCanvas(t *testing.T) {
+	t.Run("returns a canvas element", func(t *testing.T) {
+		assert.Equal(t, `<canvas></canvas>`, el.Canvas())
+	})
+}
+
+func TestEmbed(t *testing.T) {
+	t.Run("returns an embed element", func(t *testing.T) {
+		assert.Equal(t, `<embed />`, el.Embed())
+	})
+}
+
+func TestIFrame(t *testing.T) {
+	t.Run("returns an iframe element", func(t *testing.T) {
+		assert.Equal(t, `<iframe></iframe>`, el.IFrame())
+	})
+}
+
+func TestMap(t *testing.T) {
+	t.Run("returns a map element", func(t *testing.T) {
+		assert.Equal(t, `<map></map>`, el.Map())
+	})
+}
+
+func TestObject(t *testing.T) {
+	t.Run("returns an object element", func(t *testing.T) {
+		assert.Equal(t, `<object></object>`, el.Object())
+	})
+}
+
+func TestParam(t *testing.T) {
+	t.Run("returns a param element", func(t *testing.T) {
+		assert.Equal(t, `<param />`, el.Param())
+	})
+}
+
+func TestPicture(t *testing.T) {
+	t.Run("returns a picture element", func(t *testing.T) {
+		assert.Equal(t, `<picture></picture>`, el.Picture())
+	})
+}
+
+func TestSource(t *testing.T) {
+	t.Run("returns a source element", func(t *testing.T) {
+		assert.Equal(t, `<source />`, el.Source())
+	})
+}
+
+func TestTrack(t *testing.T) {
+	t.Run("returns a track element", func(t *testing.T) {
+		assert.Equal(t, `<track />`, el.Track())
+	})
+}
+
+func TestVideo(t *testing.T) {
+	t.Run("returns a video element", func(t *testing.T) {
+		assert.Equal(t, `<video></video>`, el.Video())
+	})
 }
@@ -10,31 +10,31 @@ import (
 
 func TestAddress(t *testing.T) {
 	t.Run("returns an address element", func(t *testing.T) {
-		assert.Equal(t, `<address />`, el.Address())
+		assert.Equal(t, `<address></address>`, el.Address())
 	})
 }
 
 func TestArticle(t *testing.T) {
 	t.Run("returns an article element", func(t *testing.T) {
-		assert.Equal(t, `<article />`, el.Article())
+		assert.Equal(t, `<article></article>`, el.Article())
 	})
 }
 
 func TestAside(t *testing.T) {
 	t.Run("returns an aside element", func(t *testing.T) {
-		assert.Equal(t, `<aside />`, el.Aside())
+		assert.Equal(t, `<aside></aside>`, el.Aside())
 	})
 }
 
 func TestFooter(t *testing.T) {
 	t.Run("returns a footer element", func(t *testing.T) {
-		assert.Equal(t, `<footer />`, el.Footer())
+		assert.Equal(t, `<footer></footer>`, el.Footer())
 	})
 }
 
 func TestHeader(t *testing.T) {
 	t.Run("returns a header element", func(t *testing.T) {
-		assert.Equal(t, `<header />`, el.Header())
+		assert.Equal(t, `<header></header>`, el.Header())
 	})
 }
 
@@ -76,24 +76,24 @@ func TestH6(t *testing.T) {
 
 func TestHGroup(t *testing.T) {
 	t.Run("returns an hgroup element", func(t *testing.T) {
-		assert.Equal(t, `<hgroup />`, el.HGroup())
+		assert.Equal(t, `<hgroup></hgroup>`, el.HGroup())
 	})
 }
 
 func TestMainEl(t *testing.T) {
 	t.Run("returns a main element", func(t *testing.T) {
-		assert.Equal(t, `<main />`, el.Main())
+		assert.Equal(t, `<main></main>`, el.Main())
 	})
 }
 
 func TestNav(t *testing.T) {
 	t.Run("returns a nav element", func(t *testing.T) {
-		assert.Equal(t, `<nav />`, el.Nav())
+		assert.Equal(t, `<nav></nav>`, el.Nav())
 	})
 }
 
 func TestSection(t *testing.T) {
 	t.Run("returns a section element", func(t *testing.T) {
-		assert.Equal(t, `<section />`, el.Section())
+		assert.Equal(t, `<section></section>`, el.Section())
 	})
 }
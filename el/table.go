@@ -0,0 +1,55 @@
+package el
+
+import (
+	g "github.com/maragudk/gomponents"
+)
+
+// Caption returns an element with name "caption" and the given children.
+func Caption(children ...g.Node) g.Element {
+	return g.El("caption", children...)
+}
+
+// Col returns an element with name "col" and the given children.
+func Col(children ...g.Node) g.Element {
+	return g.El("col", children...)
+}
+
+// ColGroup returns an element with name "colgroup" and the given children.
+func ColGroup(children ...g.Node) g.Element {
+	return g.El("colgroup", children...)
+}
+
+// Table returns an element with name "table" and the given children.
+func Table(children ...g.Node) g.Element {
+	return g.El("table", children...)
+}
+
+// TBody returns an element with name "tbody" and the given children.
+func TBody(children ...g.Node) g.Element {
+	return g.El("tbody", children...)
+}
+
+// Td returns an element with name "td" and the given children.
+func Td(children ...g.Node) g.Element {
+	return g.El("td", children...)
+}
+
+// TFoot returns an element with name "tfoot" and the given children.
+func TFoot(children ...g.Node) g.Element {
+	return g.El("tfoot", children...)
+}
+
+// Th returns an element with name "th" and the given children.
+func Th(children ...g.Node) g.Element {
+	return g.El("th", children...)
+}
+
+// THead returns an element with name "thead" and the given children.
+func THead(children ...g.Node) g.Element {
+	return g.El("thead", children...)
+}
+
+// Tr returns an element with name "tr" and the given children.
+func Tr(children ...g.Node) g.Element {
+	return g.El("tr", children...)
+}
@@ -0,0 +1,75 @@
+package el_test
+
+import (
+	"testing"
+
+	g "github.com/maragudk/gomponents"
+	"github.com/maragudk/gomponents/assert"
+	"github.com/maragudk/gomponents/el"
+)
+
+func TestCaption(t *testing.T) {
+	t.Run("returns a caption element", func(t *testing.T) {
+		assert.Equal(t, `<caption></caption>`, el.Caption())
+	})
+}
+
+func TestCol(t *testing.T) {
+	t.Run("returns a col element", func(t *testing.T) {
+		assert.Equal(t, `<col />`, el.Col())
+	})
+}
+
+func TestColGroup(t *testing.T) {
+	t.Run("returns a colgroup element", func(t *testing.T) {
+		assert.Equal(t, `<colgroup></colgroup>`, el.ColGroup())
+	})
+}
+
+func TestTable(t *testing.T) {
+	t.Run("returns a table element", func(t *testing.T) {
+		assert.Equal(t, `<table></table>`, el.Table())
+	})
+
+	t.Run("composes with attributes and groups", func(t *testing.T) {
+		rows := []g.Node{el.Tr(el.Td(g.Text("hat"))), el.Tr(el.Td(g.Text("partyhat")))}
+		assert.Equal(t, `<table class="hats"><tbody><tr><td>hat</td></tr><tr><td>partyhat</td></tr></tbody></table>`,
+			el.Table(g.Attr("class", "hats"), el.TBody(g.Group(rows))))
+	})
+}
+
+func TestTBody(t *testing.T) {
+	t.Run("returns a tbody element", func(t *testing.T) {
+		assert.Equal(t, `<tbody></tbody>`, el.TBody())
+	})
+}
+
+func TestTd(t *testing.T) {
+	t.Run("returns a td element", func(t *testing.T) {
+		assert.Equal(t, `<td></td>`, el.Td())
+	})
+}
+
+func TestTFoot(t *testing.T) {
+	t.Run("returns a tfoot element", func(t *testing.T) {
+		assert.Equal(t, `<tfoot></tfoot>`, el.TFoot())
+	})
+}
+
+func TestTh(t *testing.T) {
+	t.Run("returns a th element", func(t *testing.T) {
+		assert.Equal(t, `<th></th>`, el.Th())
+	})
+}
+
+func TestTHead(t *testing.T) {
+	t.Run("returns a thead element", func(t *testing.T) {
+		assert.Equal(t, `<thead></thead>`, el.THead())
+	})
+}
+
+func TestTr(t *testing.T) {
+	t.Run("returns a tr element", func(t *testing.T) {
+		assert.Equal(t, `<tr></tr>`, el.Tr())
+	})
+}
@@ -0,0 +1,64 @@
+package gomponents
+
+import (
+	"context"
+	"io"
+	"strings"
+)
+
+// ErrNode returns a Node for reporting err from a component that cannot return an error itself, like a NodeFunc.
+// It renders nothing, but when rendering with RenderWithErrors, err is collected for the caller to log or handle.
+// Otherwise, rendering fails with err, like for other Nodes that cannot render. If err is nil, it's Empty.
+// To render a placeholder as well, group it with the ErrNode.
+// It's placed Inside, so it can be placed anywhere, including in void elements.
+func ErrNode(err error) Node {
+	if err == nil {
+		return Empty
+	}
+	return errNode{err: err}
+}
+
+type errNode struct {
+	err error
+}
+
+func (e errNode) Render() string {
+	return ""
+}
+
+// RenderTo satisfies Renderer.
+func (e errNode) RenderTo(w io.Writer) error {
+	return e.RenderContext(writerContext(w), w)
+}
+
+// RenderContext satisfies ContextNode. It adds the error to the errors collected by RenderWithErrors, if any.
+func (e errNode) RenderContext(ctx context.Context, w io.Writer) error {
+	c, ok := ctx.Value(errorsKey{}).(*errorCollector)
+	if !ok {
+		return e.err
+	}
+	c.errs = append(c.errs, e.err)
+	return nil
+}
+
+func (e errNode) Place() Placement {
+	return Inside
+}
+
+type errorsKey struct{}
+
+type errorCollector struct {
+	errs []error
+}
+
+// RenderWithErrors renders n to a string, and returns it together with the errors from any ErrNode in it,
+// in the order they were rendered. Rendering continues after them.
+// If rendering fails for another reason, the error is returned last, together with what was rendered until then.
+func RenderWithErrors(n Node) (string, []error) {
+	c := &errorCollector{}
+	var b strings.Builder
+	if err := RenderContext(context.WithValue(context.Background(), errorsKey{}, c), &b, n); err != nil {
+		c.errs = append(c.errs, err)
+	}
+	return b.String(), c.errs
+}
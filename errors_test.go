@@ -0,0 +1,59 @@
+package gomponents_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	g "github.com/maragudk/gomponents"
+)
+
+func TestRenderWithErrors(t *testing.T) {
+	t.Run("collects errors from error nodes and continues rendering", func(t *testing.T) {
+		errHat, errParty := errors.New("no hats"), errors.New("no party")
+		n := g.El("div",
+			g.El("p", g.ErrNode(errHat), g.Text("Hat")),
+			g.El("input", g.ErrNode(errParty)),
+			g.ErrNode(nil),
+		)
+		s, errs := g.RenderWithErrors(n)
+		if s != "<div><p>Hat</p><input /></div>" {
+			t.Fatalf("unexpected output %v", s)
+		}
+		if len(errs) != 2 || errs[0] != errHat || errs[1] != errParty {
+			t.Fatalf("unexpected errors %v", errs)
+		}
+	})
+
+	t.Run("returns no errors if there are none", func(t *testing.T) {
+		s, errs := g.RenderWithErrors(g.El("div"))
+		if s != "<div></div>" || errs != nil {
+			t.Fatalf("unexpected output %v and errors %v", s, errs)
+		}
+	})
+
+	t.Run("collects errors once with HoistAssets", func(t *testing.T) {
+		errHat := errors.New("no hats")
+		s, errs := g.RenderWithErrors(g.HoistAssets(g.El("div", g.RequireAsset(g.El("style")), g.ErrNode(errHat))))
+		if s != "<style></style><div></div>" || len(errs) != 1 || errs[0] != errHat {
+			t.Fatalf("unexpected output %v and errors %v", s, errs)
+		}
+	})
+
+	t.Run("returns other render errors last", func(t *testing.T) {
+		s, errs := g.RenderWithErrors(g.El("div", g.ErrNode(errors.New("no party")), g.Text("Hat"), erroringNode{}))
+		if s != "<div>Hat" || len(errs) != 2 || !strings.Contains(errs[1].Error(), "no hats") {
+			t.Fatalf("unexpected output %v and errors %v", s, errs)
+		}
+	})
+}
+
+func TestErrNode(t *testing.T) {
+	t.Run("fails rendering outside RenderWithErrors", func(t *testing.T) {
+		errHat := errors.New("no hats")
+		var b strings.Builder
+		if err := g.El("div", g.ErrNode(errHat)).RenderTo(&b); !errors.Is(err, errHat) {
+			t.Fatalf("expected %v, got %v", errHat, err)
+		}
+	})
+}
@@ -0,0 +1,45 @@
+package main
+
+import (
+	"os"
+	"time"
+
+	g "github.com/maragudk/gomponents"
+	"github.com/maragudk/gomponents/xml"
+)
+
+func main() {
+	posts := []post{
+		{title: "Party hats", link: "https://example.com/party-hats", published: time.Date(2022, 3, 1, 0, 0, 0, 0, time.UTC)},
+		{title: "Turtle hats & more", link: "https://example.com/turtle-hats", published: time.Date(2022, 3, 8, 0, 0, 0, 0, time.UTC)},
+	}
+	_ = g.Write(os.Stdout, feed(posts))
+}
+
+type post struct {
+	title     string
+	link      string
+	published time.Time
+}
+
+func feed(posts []post) g.Node {
+	return xml.Document(
+		xml.El("rss", xml.Attr("version", "2.0"), xml.Attr("xmlns:atom", "http://www.w3.org/2005/Atom"),
+			xml.El("channel",
+				xml.El("title", g.Text("Hats")),
+				xml.El("link", g.Text("https://example.com")),
+				xml.El("description", g.Text("All about hats.")),
+				xml.El("atom:link", xml.Attr("href", "https://example.com/feed.xml"), xml.Attr("rel", "self"),
+					xml.Attr("type", "application/rss+xml")),
+				g.Map(posts, func(p post) g.Node {
+					return xml.El("item",
+						xml.El("title", g.Text(p.title)),
+						xml.El("link", g.Text(p.link)),
+						xml.El("guid", g.Text(p.link)),
+						xml.El("pubDate", g.Text(p.published.Format(time.RFC1123Z))),
+					)
+				}),
+			),
+		),
+	)
+}
@@ -0,0 +1,52 @@
+package gomponents
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+)
+
+// RenderFile renders n to the file at path, which is created or truncated, with buffering.
+// The parent directories of the file are created if they don't exist.
+// The file is synced to disk before it's closed, and any error along the way is returned.
+func RenderFile(path string, n Node) (err error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if closeErr := f.Close(); err == nil {
+			err = closeErr
+		}
+	}()
+
+	w := bufio.NewWriter(f)
+	if err := Write(w, n); err != nil {
+		return err
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+	return f.Sync()
+}
+
+// RenderFiles renders each Node in files to the file at its path, like RenderFile.
+// Files are rendered in path order, and rendering stops at the first error, which is returned with the path.
+func RenderFiles(files map[string]Node) error {
+	paths := make([]string, 0, len(files))
+	for path := range files {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+	for _, path := range paths {
+		if err := RenderFile(path, files[path]); err != nil {
+			return fmt.Errorf("cannot render file %v: %w", path, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,70 @@
+package gomponents_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	g "github.com/maragudk/gomponents"
+)
+
+func TestRenderFile(t *testing.T) {
+	t.Run("renders to the file and creates parent directories", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "hats", "index.html")
+		if err := g.RenderFile(path, g.El("div", g.Text("hat"))); err != nil {
+			t.Fatal(err)
+		}
+		assertFile(t, path, "<div>hat</div>")
+	})
+
+	t.Run("truncates an existing file", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "index.html")
+		if err := os.WriteFile(path, []byte("<div>partyhat</div>"), 0644); err != nil {
+			t.Fatal(err)
+		}
+		if err := g.RenderFile(path, g.Text("hat")); err != nil {
+			t.Fatal(err)
+		}
+		assertFile(t, path, "hat")
+	})
+
+	t.Run("errors on render error", func(t *testing.T) {
+		if err := g.RenderFile(filepath.Join(t.TempDir(), "index.html"), erroringNode{}); err == nil {
+			t.FailNow()
+		}
+	})
+}
+
+func TestRenderFiles(t *testing.T) {
+	t.Run("renders each node to its file", func(t *testing.T) {
+		dir := t.TempDir()
+		err := g.RenderFiles(map[string]g.Node{
+			filepath.Join(dir, "index.html"):         g.Text("hats"),
+			filepath.Join(dir, "hats", "party.html"): g.Text("partyhat"),
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		assertFile(t, filepath.Join(dir, "index.html"), "hats")
+		assertFile(t, filepath.Join(dir, "hats", "party.html"), "partyhat")
+	})
+
+	t.Run("errors with the path on render error", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "index.html")
+		err := g.RenderFiles(map[string]g.Node{path: erroringNode{}})
+		if err == nil || err.Error() != "cannot render file "+path+": no hats" {
+			t.Fatalf("unexpected error %v", err)
+		}
+	})
+}
+
+func assertFile(t *testing.T, path, expected string) {
+	t.Helper()
+	b, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(b) != expected {
+		t.Fatalf("expected %v in %v, got %v", expected, path, string(b))
+	}
+}
@@ -1,3 +1,8 @@
-module "github.com/maragudk/gomponents"
+module github.com/maragudk/gomponents
 
-go 1.15
+go 1.18
+
+require (
+	github.com/yuin/goldmark v1.6.0
+	golang.org/x/net v0.35.0
+)
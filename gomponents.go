@@ -2,16 +2,28 @@
 // The primary interface is a Node, which has a single function Render, which should render
 // the Node to a string. Furthermore, NodeFunc is a function which implements the Node interface
 // by calling itself on Render.
+// Nodes can also implement Renderer, to render directly to an io.Writer without building
+// intermediate strings. All Nodes in this package do, and Write uses it when available.
+// Nodes that implement ContextNode get a context.Context when rendered with RenderContext,
+// for request-scoped values like the current user or locale.
 // All DOM elements and attributes can be created by using the El and Attr functions.
 // The package also provides a lot of convenience functions for creating elements and attributes
 // with the most commonly used parameters. If they don't suffice, a fallback to El and Attr is always possible.
+// Nodes are immutable once built, so a Node tree is safe to render concurrently from multiple goroutines,
+// for example a shared layout, as long as the functions in it, like in NodeFunc and Lazy, are safe to call concurrently.
 package gomponents
 
 import (
+	"bytes"
+	"context"
+	"errors"
 	"fmt"
 	"html/template"
 	"io"
+	"sort"
 	"strings"
+	"sync"
+	"unicode/utf8"
 )
 
 // Node is a DOM node that can Render itself to a string representation.
@@ -19,18 +31,42 @@ type Node interface {
 	Render() string
 }
 
+// Renderer can be implemented by a Node to render itself directly to an io.Writer,
+// instead of building a string representation first.
+type Renderer interface {
+	RenderTo(w io.Writer) error
+}
+
+// ContextNode can be implemented by a Node to render itself with a context.Context, to get request-scoped values
+// without passing them to every component. Elements and Groups pass the context on to their children,
+// so it reaches any ContextNode in the tree. See RenderContext.
+type ContextNode interface {
+	RenderContext(ctx context.Context, w io.Writer) error
+}
+
 // Placer can be implemented to tell Render functions where to place the string representation of a Node
 // in the parent element.
 type Placer interface {
 	Place() Placement
 }
 
+// AttributeResolver can be implemented by a Node placed Inside that renders a single Attribute, like attr.Classes,
+// so it's merged with other attributes with the same name, see El. If it returns false, it renders nothing.
+type AttributeResolver interface {
+	ResolveAttribute() (Attribute, bool)
+}
+
 // Placement is used with the Placer interface.
 type Placement int
 
 const (
+	// Outside the start tag, as content of the element, like Elements and Text.
 	Outside = Placement(iota)
+	// Inside the start tag, like Attributes.
 	Inside
+	// Head of the document, for Nodes that belong in the "head" element no matter where they are placed,
+	// like stylesheets required by a component. See InHead.
+	Head
 )
 
 // NodeFunc is render function that is also a Node.
@@ -40,6 +76,12 @@ func (n NodeFunc) Render() string {
 	return n()
 }
 
+// RenderTo satisfies Renderer.
+func (n NodeFunc) RenderTo(w io.Writer) error {
+	_, err := io.WriteString(w, n())
+	return err
+}
+
 func (n NodeFunc) Place() Placement {
 	return Outside
 }
@@ -49,109 +91,862 @@ func (n NodeFunc) String() string {
 	return n.Render()
 }
 
-// El creates an element DOM Node with a name and child Nodes.
+// Element is an element DOM Node with a name and child Nodes. Create it with El.
+type Element struct {
+	name     string
+	children []Node
+	foreign  bool
+}
+
+// El creates an element DOM Node with a name and child Nodes. Nil children are skipped.
+// Attributes with the same name are merged into one, at the position of the first: values of "class"
+// are joined with spaces, values of "style" are joined with semicolons, and for other attributes the last one wins.
+// This only applies to attributes created with Attr and the helpers using it.
 // Use this if no convenience creator exists.
-func El(name string, children ...Node) NodeFunc {
-	return func() string {
-		var b, inside, outside strings.Builder
+func El(name string, children ...Node) Element {
+	return Element{name: name, children: children}
+}
 
-		b.WriteString("<")
-		b.WriteString(name)
+// ForeignEl creates an element DOM Node like El, but for elements that are not HTML elements,
+// like SVG or XML elements. These are rendered self-closing like "<circle />" if they have
+// no Outside children, and the HTML rules for void and raw text elements don't apply to them.
+func ForeignEl(name string, children ...Node) Element {
+	return Element{name: name, children: children, foreign: true}
+}
 
-		if len(children) == 0 {
-			b.WriteString(" />")
-			return b.String()
-		}
+// Name of the element.
+func (e Element) Name() string {
+	return e.name
+}
 
+// With returns a copy of the element with the given children added after the existing ones.
+// Added attributes are merged with existing ones with the same name, see El.
+func (e Element) With(children ...Node) Element {
+	newChildren := make([]Node, len(e.children), len(e.children)+len(children))
+	copy(newChildren, e.children)
+	e.children = append(newChildren, children...)
+	return e
+}
+
+// Children of the element, as given to El. The returned slice is a copy.
+func (e Element) Children() []Node {
+	children := make([]Node, len(e.children))
+	copy(children, e.children)
+	return children
+}
+
+// Attributes of the element created with Attr and the helpers using it, including attributes in Groups
+// and from AttributeResolvers,
+// in the order they were given to El. Attributes with the same name are not merged.
+func (e Element) Attributes() []Attribute {
+	var attrs []Attribute
+	var collect func(children []Node)
+	collect = func(children []Node) {
 		for _, c := range children {
-			renderChild(c, &inside, &outside)
+			switch c := c.(type) {
+			case group:
+				collect(c.children)
+			case Attribute:
+				attrs = append(attrs, c)
+			case AttributeResolver:
+				if a, ok := c.ResolveAttribute(); ok {
+					attrs = append(attrs, a)
+				}
+			}
+		}
+	}
+	collect(e.children)
+	return attrs
+}
+
+// Attribute of the element with the given name, and whether there is one.
+// If there are several with the name, they are merged like when rendering, see El.
+func (e Element) Attribute(name string) (Attribute, bool) {
+	var attr Attribute
+	var found bool
+	for _, a := range e.Attributes() {
+		if a.name != name {
+			continue
 		}
+		if found {
+			attr = mergeAttribute(attr, a)
+			continue
+		}
+		attr, found = a, true
+	}
+	return attr, found
+}
 
-		b.WriteString(inside.String())
+func (e Element) Render() string {
+	return renderString(e)
+}
 
-		if outside.Len() == 0 {
-			b.WriteString(" />")
-			return b.String()
+// RenderTo satisfies Renderer. The opening tag and all Inside children are written first,
+// then the Outside children, and finally the closing tag.
+// Void elements (see IsVoidElement) are self-closing, and their Outside children are not rendered.
+// Rendering stops at the first error, which is returned with the name of the failing element.
+func (e Element) RenderTo(w io.Writer) error {
+	return e.RenderContext(writerContext(w), w)
+}
+
+// WriteTo satisfies io.WriterTo. It renders like RenderTo, and returns the number of bytes written, including all children.
+func (e Element) WriteTo(w io.Writer) (int64, error) {
+	return writeTo(w, e)
+}
+
+// RenderContext satisfies ContextNode. It renders like RenderTo, passing ctx on to the children.
+func (e Element) RenderContext(ctx context.Context, w io.Writer) error {
+	if err := e.renderTo(ctx, w); err != nil {
+		var re renderError
+		if errors.As(err, &re) {
+			return err
+		}
+		return renderError{name: e.name, err: err}
+	}
+	return nil
+}
+
+func (e Element) renderTo(ctx context.Context, w io.Writer) error {
+	sw, ok := w.(*statefulWriter)
+	if !ok {
+		sw = getStatefulWriter(w)
+		defer putStatefulWriter(sw)
+	}
+	if err := contextErr(ctx); err != nil {
+		return err
+	}
+
+	prevCtx := sw.ctx
+	sw.ctx = ctx
+	defer func() { sw.ctx = prevCtx }()
+
+	if e.name == "html" && !e.foreign {
+		e = hoistHead(ctx, e)
+	}
+
+	sw.write("<")
+	sw.write(e.name)
+
+	inside := e.children
+	if (e.name == "script" || e.name == "style") && !e.foreign {
+		inside = addNonce(ctx, e, inside)
+	}
+	if h, ok := ctx.Value(elementHookKey{}).(ElementHook); ok && h != nil {
+		inside = hookAttributes(h, e.name, e.children)
+	}
+	if err := renderInside(sw, inside); err != nil {
+		return err
+	}
+
+	if e.isSelfClosing() {
+		sw.write(selfClosingEnd(ctx, e.foreign))
+		return afterElement(ctx, e.name, sw.err)
+	}
+
+	sw.write(">")
+
+	_, rawText := rawTextElements[e.name]
+	rawText = rawText && !e.foreign
+	for _, c := range e.children {
+		if rawText {
+			if err := renderRawTextChild(sw, e.name, c); err != nil {
+				return err
+			}
+			continue
+		}
+		if err := renderChild(sw, c, Outside); err != nil {
+			return err
+		}
+	}
+
+	sw.write("</")
+	sw.write(e.name)
+	sw.write(">")
+	return afterElement(ctx, e.name, sw.err)
+}
+
+// afterElement calls the AfterElementHook in ctx with name, if there is one and err is nil, and returns err.
+func afterElement(ctx context.Context, name string, err error) error {
+	if err != nil {
+		return err
+	}
+	if h, ok := ctx.Value(afterElementHookKey{}).(AfterElementHook); ok && h != nil {
+		h(name)
+	}
+	return nil
+}
+
+// contextErr returns the error of ctx if it's done. It doesn't lock like ctx.Err, and does nothing for contexts
+// that can't be cancelled, like context.Background, so it's cheap to call for every element.
+func contextErr(ctx context.Context) error {
+	done := ctx.Done()
+	if done == nil {
+		return nil
+	}
+	select {
+	case <-done:
+		return ctx.Err()
+	default:
+		return nil
+	}
+}
+
+// isSelfClosing returns whether e is rendered without content and closing tag.
+func (e Element) isSelfClosing() bool {
+	if e.foreign {
+		return !hasOutside(e.children)
+	}
+	return IsVoidElement(e.name)
+}
+
+// hasOutside checks whether any of the children, including children of groups, is placed Outside.
+// Nodes that render nothing, like nil Nodes or from If with a false condition, don't count.
+func hasOutside(children []Node) bool {
+	for _, c := range children {
+		switch c := c.(type) {
+		case group:
+			if hasOutside(c.children) {
+				return true
+			}
+			continue
+		case empty, nil:
+			continue
+		}
+		if placement(c) == Outside {
+			return true
 		}
+	}
+	return false
+}
+
+func (e Element) Place() Placement {
+	return Outside
+}
+
+// String satisfies fmt.Stringer.
+func (e Element) String() string {
+	return e.Render()
+}
 
-		b.WriteString(">")
-		b.WriteString(outside.String())
-		b.WriteString("</")
-		b.WriteString(name)
-		b.WriteString(">")
-		return b.String()
+// voidElements don't have any content and no closing tag.
+// See https://developer.mozilla.org/en-US/docs/Glossary/Void_element
+var voidElements = map[string]struct{}{
+	"area":   {},
+	"base":   {},
+	"br":     {},
+	"col":    {},
+	"embed":  {},
+	"hr":     {},
+	"img":    {},
+	"input":  {},
+	"link":   {},
+	"meta":   {},
+	"param":  {},
+	"source": {},
+	"track":  {},
+	"wbr":    {},
+}
+
+// rawTextElements have content that the browser doesn't unescape, so Text children are rendered unescaped,
+// except for an end tag of the element.
+// See https://html.spec.whatwg.org/multipage/syntax.html#raw-text-elements
+var rawTextElements = map[string]struct{}{
+	"script": {},
+	"style":  {},
+}
+
+// IsVoidElement returns whether the element with the given name is a void element,
+// which is rendered self-closing without any content, like "<br />".
+func IsVoidElement(name string) bool {
+	_, ok := voidElements[name]
+	return ok
+}
+
+// RegisterVoidElement registers additional element names as void elements, for example for web components.
+// It is not safe to call concurrently with rendering, so call it during initialization, like in an init function.
+func RegisterVoidElement(names ...string) {
+	for _, name := range names {
+		voidElements[name] = struct{}{}
 	}
 }
 
-func renderChild(c Node, inside, outside *strings.Builder) {
+// placement of c in the parent element. Nodes that don't implement Placer default to Outside.
+func placement(c Node) Placement {
+	if p, ok := c.(Placer); ok {
+		return p.Place()
+	}
+	return Outside
+}
+
+// renderChild renders c to w, if it has the given Placement. Groups are rendered child by child,
+// and nil Nodes are skipped.
+func renderChild(w *statefulWriter, c Node, p Placement) error {
+	if c == nil {
+		return nil
+	}
 	if g, ok := c.(group); ok {
 		for _, groupC := range g.children {
-			renderChild(groupC, inside, outside)
+			if err := renderChild(w, groupC, p); err != nil {
+				return err
+			}
 		}
-		return
+		return nil
 	}
-	if p, ok := c.(Placer); ok {
-		switch p.Place() {
-		case Inside:
-			inside.WriteString(c.Render())
-		case Outside:
-			outside.WriteString(c.Render())
+	switch placement(c) {
+	case p:
+	case Head:
+		if p != Outside {
+			return nil
+		}
+		if w.err != nil {
+			return w.err
+		}
+		return renderHead(writerContext(w), w, c)
+	default:
+		return nil
+	}
+	if w.err != nil {
+		return w.err
+	}
+	return render(w, c)
+}
+
+// renderInside renders the Inside children of an element to w, merging attributes with the same name (see El),
+// and sorting them if requested with WithSortedAttributes.
+func renderInside(w *statefulWriter, children []Node) error {
+	sorted := sortedAttributes(writerContext(w))
+	var names [8]string
+	if !sorted && !hasDuplicates(attributeNames(children, names[:0])) {
+		for _, c := range children {
+			if err := renderChild(w, c, Inside); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	nodes := mergeAttributes(children)
+	if sorted {
+		sort.SliceStable(nodes, func(i, j int) bool {
+			return attributeLess(nodes[i], nodes[j])
+		})
+	}
+	for _, c := range nodes {
+		if err := renderChild(w, c, Inside); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// attributeNames appends the names of all Attribute children, including children of groups, to names.
+func attributeNames(children []Node, names []string) []string {
+	for _, c := range children {
+		switch c := c.(type) {
+		case group:
+			names = attributeNames(c.children, names)
+		case Attribute:
+			names = append(names, c.name)
+		case AttributeResolver:
+			if a, ok := c.ResolveAttribute(); ok {
+				names = append(names, a.name)
+			}
+		}
+	}
+	return names
+}
+
+func hasDuplicates(names []string) bool {
+	for i := range names {
+		for j := i + 1; j < len(names); j++ {
+			if names[i] == names[j] {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// mergeAttributes returns the Inside children, with groups flattened and attributes with the same name merged.
+func mergeAttributes(children []Node) []Node {
+	var nodes []Node
+	indexes := map[string]int{}
+	add := func(a Attribute) {
+		if i, ok := indexes[a.name]; ok {
+			nodes[i] = mergeAttribute(nodes[i].(Attribute), a)
+			return
+		}
+		indexes[a.name] = len(nodes)
+		nodes = append(nodes, a)
+	}
+	var merge func(children []Node)
+	merge = func(children []Node) {
+		for _, c := range children {
+			switch c := c.(type) {
+			case group:
+				merge(c.children)
+			case Attribute:
+				add(c)
+			case AttributeResolver:
+				if a, ok := c.ResolveAttribute(); ok {
+					add(a)
+				}
+			default:
+				if placement(c) == Inside {
+					nodes = append(nodes, c)
+				}
+			}
 		}
-		return
 	}
-	// If c doesn't implement Placer, default to outside
-	outside.WriteString(c.Render())
+	merge(children)
+	return nodes
+}
+
+// mergeAttribute a with the later attribute b with the same name, see El.
+func mergeAttribute(a, b Attribute) Attribute {
+	var sep string
+	switch a.name {
+	case "class":
+		sep = " "
+	case "style":
+		sep = ";"
+	default:
+		return b
+	}
+	av, _ := a.Value()
+	bv, _ := b.Value()
+	// If one of the values is raw, the result is raw, so the other is escaped first
+	raw := a.raw || b.raw
+	if raw && !a.raw {
+		av = template.HTMLEscapeString(av)
+	}
+	if raw && !b.raw {
+		bv = template.HTMLEscapeString(bv)
+	}
+	av = strings.TrimRight(strings.TrimSpace(av), sep)
+	bv = strings.TrimSpace(bv)
+	switch {
+	case av == "":
+		av = bv
+	case bv != "":
+		av += sep + bv
+	}
+	return Attribute{name: a.name, value: &av, raw: raw}
+}
+
+// renderRawTextChild renders c to w like renderChild with Outside placement, but Text and T are not escaped.
+// Only an end tag for the element with the given name is escaped, so the text can't end the element early.
+func renderRawTextChild(w *statefulWriter, name string, c Node) error {
+	switch c := c.(type) {
+	case group:
+		for _, groupC := range c.children {
+			if err := renderRawTextChild(w, name, groupC); err != nil {
+				return err
+			}
+		}
+		return nil
+	case text:
+		w.write(escapeRawText(string(c), name))
+		return w.err
+	case translation:
+		w.write(escapeRawText(c.text(writerContext(w)), name))
+		return w.err
+	}
+	return renderChild(w, c, Outside)
+}
+
+// escapeRawText returns s with each case-insensitive "</" followed by name replaced with "<\/",
+// like "<\/script", which is the same in JavaScript strings and CSS, but doesn't end the element.
+func escapeRawText(s, name string) string {
+	if !strings.Contains(s, "</") {
+		return s
+	}
+	var b strings.Builder
+	for {
+		i := strings.Index(s, "</")
+		if i < 0 {
+			b.WriteString(s)
+			return b.String()
+		}
+		b.WriteString(s[:i])
+		if rest := s[i+2:]; len(rest) >= len(name) && strings.EqualFold(rest[:len(name)], name) {
+			b.WriteString(`<\/`)
+		} else {
+			b.WriteString("</")
+		}
+		s = s[i+2:]
+	}
+}
+
+// render n to w, using ContextNode or Renderer if n implements it. A nil n renders nothing.
+func render(w io.Writer, n Node) error {
+	if n == nil {
+		return nil
+	}
+	if cn, ok := n.(ContextNode); ok {
+		return cn.RenderContext(writerContext(w), w)
+	}
+	if r, ok := n.(Renderer); ok {
+		return r.RenderTo(w)
+	}
+	_, err := io.WriteString(w, n.Render())
+	return err
+}
+
+// renderError wraps an error from rendering with the name of the element that failed.
+type renderError struct {
+	name string
+	err  error
+}
+
+func (e renderError) Error() string {
+	return fmt.Sprintf("cannot render element %v: %v", e.name, e.err)
+}
+
+func (e renderError) Unwrap() error {
+	return e.err
+}
+
+// writeTo renders r to w, and returns the number of bytes written, for io.WriterTo.
+func writeTo(w io.Writer, r Renderer) (int64, error) {
+	cw := &passthroughCountingWriter{w: w}
+	err := r.RenderTo(cw)
+	return cw.n, err
+}
+
+// passthroughCountingWriter counts the bytes written to w through it.
+type passthroughCountingWriter struct {
+	w io.Writer
+	n int64
+}
+
+func (w *passthroughCountingWriter) Write(p []byte) (int, error) {
+	n, err := w.w.Write(p)
+	w.n += int64(n)
+	return n, err
+}
+
+// WriteString satisfies io.StringWriter, so strings are passed on without conversion to bytes where possible.
+func (w *passthroughCountingWriter) WriteString(s string) (int, error) {
+	n, err := io.WriteString(w.w, s)
+	w.n += int64(n)
+	return n, err
+}
+
+// statefulWriter remembers the first error from the underlying io.Writer and skips all writes after it.
+// It also carries the context of the current render, so children get it without changing the Renderer interface.
+type statefulWriter struct {
+	w   io.Writer
+	err error
+	ctx context.Context
+}
+
+// writerContext returns the context of the render that w belongs to, or context.Background if there is none.
+func writerContext(w io.Writer) context.Context {
+	if sw, ok := w.(*statefulWriter); ok && sw.ctx != nil {
+		return sw.ctx
+	}
+	return context.Background()
+}
+
+func (w *statefulWriter) Write(p []byte) (int, error) {
+	if w.err != nil {
+		return 0, w.err
+	}
+	var n int
+	n, w.err = w.w.Write(p)
+	return n, w.err
+}
+
+// WriteString satisfies io.StringWriter, so strings are written without conversion to bytes
+// if the underlying io.Writer supports it.
+func (w *statefulWriter) WriteString(s string) (int, error) {
+	if w.err != nil {
+		return 0, w.err
+	}
+	var n int
+	n, w.err = io.WriteString(w.w, s)
+	return n, w.err
+}
+
+// write s, ignoring the error, which is available in err afterwards.
+func (w *statefulWriter) write(s string) {
+	_, _ = w.WriteString(s)
+}
+
+var statefulWriterPool = sync.Pool{
+	New: func() interface{} {
+		return &statefulWriter{}
+	},
+}
+
+// getStatefulWriter returns a pooled statefulWriter for w. Return it with putStatefulWriter when done.
+func getStatefulWriter(w io.Writer) *statefulWriter {
+	sw := statefulWriterPool.Get().(*statefulWriter)
+	sw.w = w
+	return sw
+}
+
+func putStatefulWriter(sw *statefulWriter) {
+	sw.w = nil
+	sw.err = nil
+	sw.ctx = nil
+	statefulWriterPool.Put(sw)
+}
+
+var bufferPool = sync.Pool{
+	New: func() interface{} {
+		return &bytes.Buffer{}
+	},
+}
+
+// renderString renders r to a pooled buffer and returns the result, for implementing Render with RenderTo.
+func renderString(r Renderer) string {
+	b := bufferPool.Get().(*bytes.Buffer)
+	b.Reset()
+	_ = r.RenderTo(b)
+	s := b.String()
+	bufferPool.Put(b)
+	return s
+}
+
+// writeEscaped writes s to w escaped like template.HTMLEscapeString does, but without allocating.
+func writeEscaped(w io.Writer, s string) error {
+	last := 0
+	for i := 0; i < len(s); i++ {
+		var entity string
+		switch s[i] {
+		case '"':
+			entity = "&#34;"
+		case '\'':
+			entity = "&#39;"
+		case '&':
+			entity = "&amp;"
+		case '<':
+			entity = "&lt;"
+		case '>':
+			entity = "&gt;"
+		case 0:
+			entity = "\uFFFD"
+		default:
+			continue
+		}
+		if err := writeStrings(w, s[last:i], entity); err != nil {
+			return err
+		}
+		last = i + 1
+	}
+	_, err := io.WriteString(w, s[last:])
+	return err
+}
+
+// writeStrings writes all strings to w, stopping at the first error.
+func writeStrings(w io.Writer, ss ...string) error {
+	for _, s := range ss {
+		if _, err := io.WriteString(w, s); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 // Attr creates an attr DOM Node.
 // If one parameter is passed, it's a name-only attribute (like "required").
 // If two parameters are passed, it's a name-value attribute (like `class="header"`).
-// More parameter counts make Attr panic.
+// More parameter counts make Attr panic. See NameAttr and ValueAttr for explicit forms that cannot.
+// The value is HTML-escaped when rendered, so it cannot break out of the attribute.
+// Characters that aren't allowed in attribute names, like whitespace, quotes, and angle brackets,
+// are removed from the name, so it cannot break out of the tag either. If no name is left, nothing is rendered.
 // Use this if no convenience creator exists.
 func Attr(name string, value ...string) Node {
 	switch len(value) {
 	case 0:
-		return attr{name: name}
+		return Attribute{name: attributeName(name)}
 	case 1:
-		return attr{name: name, value: &value[0]}
+		return Attribute{name: attributeName(name), value: &value[0]}
 	default:
 		panic("attribute must be just name or name and value pair")
 	}
 }
 
-type attr struct {
+// NameAttr creates a name-only attr DOM Node (like "required"). It's the explicit form of Attr with one argument.
+func NameAttr(name string) Node {
+	return Attribute{name: attributeName(name)}
+}
+
+// ValueAttr creates a name-value attr DOM Node (like `class="header"`), which renders the value even if it's empty,
+// like `value=""`. It's the explicit form of Attr with two arguments.
+func ValueAttr(name, value string) Node {
+	return Attribute{name: attributeName(name), value: &value}
+}
+
+// Attrf creates a name-value attr DOM Node with the interpolated string as value, like Textf does for text,
+// for example Attrf("style", "width:%vpx", width). The value is escaped like with Attr.
+func Attrf(name, format string, a ...interface{}) Node {
+	return ValueAttr(name, fmt.Sprintf(format, a...))
+}
+
+// RawAttr creates a name-value attr DOM Node with a value that is already escaped, which is rendered as-is,
+// like Raw does for text. Use it only for values from a trusted HTML escaper, to not escape them twice.
+// Be careful: an unescaped quote in the value ends the attribute, so untrusted values can inject attributes
+// like event handlers. Use Attr for everything else.
+func RawAttr(name, value string) Node {
+	return Attribute{name: attributeName(name), value: &value, raw: true}
+}
+
+// Attrs creates attr DOM Nodes for each name and value in m, in a Group sorted by name,
+// for attributes that are only known at runtime. Values are escaped like with Attr.
+// An empty value creates a name-only attribute (like "required"), so use ValueAttr for empty values.
+func Attrs(m map[string]string) Node {
+	names := make([]string, 0, len(m))
+	for name := range m {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	attrs := make([]Node, 0, len(names))
+	for _, name := range names {
+		if m[name] == "" {
+			attrs = append(attrs, Attr(name))
+			continue
+		}
+		attrs = append(attrs, Attr(name, m[name]))
+	}
+	return Group(attrs)
+}
+
+// BoolAttr creates a name-only attr DOM Node (like "disabled") if present is true,
+// and Empty otherwise. Useful for boolean attributes, which are false when absent, not when their value is "false".
+func BoolAttr(name string, present bool) Node {
+	if present {
+		return Attribute{name: attributeName(name)}
+	}
+	return Empty
+}
+
+// attributeName returns name without the characters that aren't allowed in attribute names,
+// which are whitespace and other control characters, quotes, "=", "/", and angle brackets.
+// See https://html.spec.whatwg.org/multipage/syntax.html#attributes-2
+func attributeName(name string) string {
+	if strings.IndexFunc(name, isInvalidAttributeNameRune) < 0 {
+		return name
+	}
+	return strings.Map(func(r rune) rune {
+		if isInvalidAttributeNameRune(r) {
+			return -1
+		}
+		return r
+	}, name)
+}
+
+func isInvalidAttributeNameRune(r rune) bool {
+	switch r {
+	case '"', '\'', '=', '/', '<', '>', utf8.RuneError:
+		return true
+	}
+	return r <= ' ' || (r >= 0x7f && r <= 0x9f)
+}
+
+// Attribute is an attribute DOM Node with a name and an optional value. Create it with Attr.
+type Attribute struct {
 	name  string
 	value *string
+	raw   bool
 }
 
-func (a attr) Render() string {
+// Name of the attribute.
+func (a Attribute) Name() string {
+	return a.name
+}
+
+// Value of the attribute, and whether it has one. Name-only attributes (like "required") don't.
+// For attributes created with RawAttr, the value is the pre-escaped one.
+func (a Attribute) Value() (string, bool) {
 	if a.value == nil {
-		return fmt.Sprintf(" %v", a.name)
+		return "", false
 	}
-	return fmt.Sprintf(` %v="%v"`, a.name, *a.value)
+	return *a.value, true
+}
+
+func (a Attribute) Render() string {
+	return renderString(a)
 }
 
-func (a attr) Place() Placement {
+// RenderTo satisfies Renderer.
+func (a Attribute) RenderTo(w io.Writer) error {
+	// An attribute without a name would be malformed, like `="z"`
+	if a.name == "" {
+		return nil
+	}
+	if a.value == nil {
+		return writeStrings(w, " ", a.name)
+	}
+	if err := writeStrings(w, " ", a.name, `="`); err != nil {
+		return err
+	}
+	if a.raw {
+		return writeStrings(w, *a.value, `"`)
+	}
+	if err := writeEscaped(w, *a.value); err != nil {
+		return err
+	}
+	_, err := io.WriteString(w, `"`)
+	return err
+}
+
+// WriteTo satisfies io.WriterTo. It renders like RenderTo, and returns the number of bytes written.
+func (a Attribute) WriteTo(w io.Writer) (int64, error) {
+	return writeTo(w, a)
+}
+
+func (a Attribute) Place() Placement {
 	return Inside
 }
 
 // String satisfies fmt.Stringer.
-func (a attr) String() string {
+func (a Attribute) String() string {
 	return a.Render()
 }
 
 // Text creates a text DOM Node that Renders the escaped string t.
-func Text(t string) NodeFunc {
-	return func() string {
-		return template.HTMLEscapeString(t)
-	}
+// As direct child of a raw text element like "script" or "style", t is rendered unescaped,
+// because the browser doesn't unescape the content of these elements.
+func Text(t string) Node {
+	return text(t)
 }
 
 // Textf creates a text DOM Node that Renders the interpolated and escaped string t.
-func Textf(format string, a ...interface{}) NodeFunc {
-	return func() string {
-		return template.HTMLEscapeString(fmt.Sprintf(format, a...))
-	}
+// Like with Text, the string is not escaped as direct child of a raw text element.
+func Textf(format string, a ...interface{}) Node {
+	return text(fmt.Sprintf(format, a...))
+}
+
+type text string
+
+func (t text) Render() string {
+	return template.HTMLEscapeString(string(t))
+}
+
+// RenderTo satisfies Renderer.
+func (t text) RenderTo(w io.Writer) error {
+	return t.RenderContext(writerContext(w), w)
+}
+
+// WriteTo satisfies io.WriterTo. It renders like RenderTo, and returns the number of bytes written.
+func (t text) WriteTo(w io.Writer) (int64, error) {
+	return writeTo(w, t)
+}
+
+// RenderContext satisfies ContextNode. The text is escaped with the Escaper from ctx, see WithEscaper.
+func (t text) RenderContext(ctx context.Context, w io.Writer) error {
+	return escaper(ctx)(w, string(t))
+}
+
+func (t text) Place() Placement {
+	return Outside
+}
+
+// String satisfies fmt.Stringer.
+func (t text) String() string {
+	return t.Render()
 }
 
 // Raw creates a raw Node that just Renders the unescaped string t.
@@ -161,10 +956,95 @@ func Raw(t string) NodeFunc {
 	}
 }
 
-// Write to the given io.Writer, returning any error.
-func Write(w io.Writer, n Node) error {
-	_, err := w.Write([]byte(n.Render()))
-	return err
+// Rawf creates a raw Node that Renders the interpolated and unescaped string, like Raw(fmt.Sprintf(format, a...)).
+// Like with Raw, the caller is responsible for the safety of the result: escape any untrusted values first.
+func Rawf(format string, a ...interface{}) NodeFunc {
+	s := fmt.Sprintf(format, a...)
+	return func() string {
+		return s
+	}
+}
+
+// Comment creates a comment DOM Node that Renders "<!-- t -->".
+// Any "-->" and "--!>" in t is escaped, so the comment cannot be closed early.
+func Comment(t string) NodeFunc {
+	return func() string {
+		return "<!-- " + commentEscaper.Replace(t) + " -->"
+	}
+}
+
+var commentEscaper = strings.NewReplacer("-->", "--&gt;", "--!>", "--!&gt;")
+
+// RawComment creates a comment DOM Node that Renders "<!--t-->", with t unescaped.
+// Useful for conditional comments like "[if lt IE 9]>...<![endif]".
+// Note that t must not contain "-->", or the comment will be closed early.
+func RawComment(t string) NodeFunc {
+	return func() string {
+		return "<!--" + t + "-->"
+	}
+}
+
+// MSOConditional wraps content in a conditional comment for Microsoft Outlook,
+// like "<!--[if mso]>content<![endif]-->" for the condition "mso", so only Outlook renders it.
+// Useful for VML and table fallbacks in HTML emails. The condition is not escaped, and must not contain "]>".
+func MSOConditional(condition string, content Node) Node {
+	return Group([]Node{Raw("<!--[if " + condition + "]>"), content, Raw("<![endif]-->")})
+}
+
+// NotMSOConditional wraps content in a conditional comment for everything but Microsoft Outlook,
+// like "<!--[if !mso]><!-->content<!--<![endif]-->", so Outlook ignores it.
+func NotMSOConditional(content Node) Node {
+	return Group([]Node{Raw("<!--[if !mso]><!-->"), content, Raw("<!--<![endif]-->")})
+}
+
+// Write the nodes to the given io.Writer, returning any error.
+// Nodes that implement Renderer are rendered directly to w, and nil Nodes are skipped.
+// Multiple nodes are written like a Group of them, which is useful for responses with several fragments.
+// Writing stops at the first error.
+// To also write the output somewhere else while streaming, like to a log, use io.MultiWriter for w:
+// the nodes are rendered once, and nothing more is written to any of the writers after one of them fails.
+func Write(w io.Writer, nodes ...Node) error {
+	if len(nodes) == 1 {
+		return render(w, nodes[0])
+	}
+	return render(w, group{children: nodes})
+}
+
+// RenderContext renders n to w like Write, passing ctx to n and all ContextNodes in it.
+// Nodes that don't implement ContextNode are rendered as usual.
+// If ctx is cancelled or its deadline is exceeded, rendering stops at the next element,
+// and the error from ctx is returned, wrapped with the name of the element, so it can be checked with errors.Is.
+func RenderContext(ctx context.Context, w io.Writer, n Node) error {
+	if cn, ok := n.(ContextNode); ok {
+		return cn.RenderContext(ctx, w)
+	}
+	return render(w, n)
+}
+
+// HTML renders n and returns it as template.HTML, so it can be embedded in html/template templates
+// without being escaped again.
+// Note that html/template trusts the result completely, so n must already be correctly escaped,
+// which is the case for Nodes built with this package, except for Raw and RawComment content.
+func HTML(n Node) template.HTML {
+	return template.HTML(n.Render())
+}
+
+// RenderBytes renders n like Render, but returns the result as bytes, for example for writing to a file or hashing.
+// It's cheaper than converting the result of Render, because the result is copied only once.
+func RenderBytes(n Node) []byte {
+	b := bufferPool.Get().(*bytes.Buffer)
+	b.Reset()
+	_ = render(b, n)
+	result := make([]byte, b.Len())
+	copy(result, b.Bytes())
+	bufferPool.Put(b)
+	return result
+}
+
+// RenderBuffer renders n like Write, appending to b without resetting it.
+// Reuse b, for example from a sync.Pool, to render without allocating a new buffer each time.
+func RenderBuffer(b *bytes.Buffer, n Node) error {
+	return render(b, n)
 }
 
 type group struct {
@@ -172,12 +1052,280 @@ type group struct {
 }
 
 func (g group) Render() string {
-	panic("cannot render group")
+	return renderString(g)
+}
+
+// RenderTo satisfies Renderer. Like in an element, the Inside children are rendered before the Outside children.
+func (g group) RenderTo(w io.Writer) error {
+	return g.RenderContext(writerContext(w), w)
+}
+
+// WriteTo satisfies io.WriterTo. It renders like RenderTo, and returns the number of bytes written, including all children.
+func (g group) WriteTo(w io.Writer) (int64, error) {
+	return writeTo(w, g)
+}
+
+// RenderContext satisfies ContextNode. It renders like RenderTo, passing ctx on to the children.
+func (g group) RenderContext(ctx context.Context, w io.Writer) error {
+	sw, ok := w.(*statefulWriter)
+	if !ok {
+		sw = getStatefulWriter(w)
+		defer putStatefulWriter(sw)
+	}
+	prevCtx := sw.ctx
+	sw.ctx = ctx
+	defer func() { sw.ctx = prevCtx }()
+
+	for _, p := range []Placement{Inside, Outside} {
+		for _, c := range g.children {
+			if err := renderChild(sw, c, p); err != nil {
+				return err
+			}
+		}
+	}
+	return sw.err
+}
+
+// String satisfies fmt.Stringer.
+func (g group) String() string {
+	return g.Render()
 }
 
 // Group multiple Nodes into one Node. Useful for concatenation of Nodes in variadic functions.
-// The resulting Node cannot Render directly, trying it will panic.
-// Render must happen through a parent element created with El or a helper.
+// Inside a parent element created with El or a helper, the children are rendered as children of that element.
+// On its own, the Group renders its children one after the other, which is useful for fragments.
+// Groups in children are flattened into the new Group, so rendering doesn't have to descend into them.
 func Group(children []Node) Node {
+	for _, c := range children {
+		if _, ok := c.(group); ok {
+			return group{children: flattenGroups(make([]Node, 0, len(children)), children)}
+		}
+	}
 	return group{children: children}
 }
+
+// flattenGroups appends children to nodes, with the children of groups instead of the groups themselves.
+func flattenGroups(nodes []Node, children []Node) []Node {
+	for _, c := range children {
+		if g, ok := c.(group); ok {
+			nodes = flattenGroups(nodes, g.children)
+			continue
+		}
+		nodes = append(nodes, c)
+	}
+	return nodes
+}
+
+// Join returns a Group of the nodes, with sep between each of them, like Join(Text(", "), a, b, c).
+// Nil nodes are skipped, and Inside nodes like attributes are kept without separators.
+func Join(sep Node, nodes ...Node) Node {
+	children := make([]Node, 0, 2*len(nodes))
+	var joined bool
+	for _, n := range nodes {
+		if n == nil {
+			continue
+		}
+		if placement(n) == Inside {
+			children = append(children, n)
+			continue
+		}
+		if joined {
+			children = append(children, sep)
+		}
+		children = append(children, n)
+		joined = true
+	}
+	return Group(children)
+}
+
+// Space returns a text Node with a single space, for spaces between inline Nodes.
+func Space() Node {
+	return text(" ")
+}
+
+// NBSP returns a Node with a non-breaking space entity, "&nbsp;".
+func NBSP() Node {
+	return Raw("&nbsp;")
+}
+
+// If condition is true, return the given Node. Otherwise, return Empty.
+// Useful for conditionally including Nodes in variadic functions, like If(loggedIn, UserMenu()).
+func If(condition bool, n Node) Node {
+	if condition {
+		return n
+	}
+	return Empty
+}
+
+// IfElse returns a if condition is true, and b otherwise.
+func IfElse(condition bool, a, b Node) Node {
+	if condition {
+		return a
+	}
+	return b
+}
+
+// IfFunc is like If, but calls build for the Node only if condition is true, so the Node isn't built otherwise.
+// Use it when building the Node is expensive, or only works if condition is true, like dereferencing a pointer:
+//
+//	g.IfFunc(user != nil, func() g.Node { return UserMenu(user.Name) })
+func IfFunc(condition bool, build func() Node) Node {
+	if condition {
+		return build()
+	}
+	return Empty
+}
+
+// IfElseFunc is like IfElse, but calls only the build function for the returned Node, a if condition is true,
+// and b otherwise.
+func IfElseFunc(condition bool, a, b func() Node) Node {
+	if condition {
+		return a()
+	}
+	return b()
+}
+
+// Switch returns the Node of the first case created with Case that matches value,
+// or the Node of the case created with Default if none match.
+// If there is no match and no default, it returns Empty.
+//
+//	g.Switch(order.Status,
+//		g.Case(Paid, Badge("Paid")),
+//		g.Case(Shipped, Badge("Shipped")),
+//		g.Default[Status](Badge("Pending")),
+//	)
+func Switch[T comparable](value T, cases ...SwitchCase[T]) Node {
+	defaultNode := Empty
+	for _, c := range cases {
+		if c.isDefault {
+			defaultNode = c.node
+			continue
+		}
+		if c.value == value {
+			return c.node
+		}
+	}
+	return defaultNode
+}
+
+// SwitchCase is a case for Switch. Create it with Case or Default.
+type SwitchCase[T comparable] struct {
+	value     T
+	node      Node
+	isDefault bool
+}
+
+// Case for Switch, which matches if the value is equal to v.
+func Case[T comparable](v T, n Node) SwitchCase[T] {
+	return SwitchCase[T]{value: v, node: n}
+}
+
+// Default case for Switch, if no other case matches.
+// The value type cannot be inferred, so it must be given, like Default[string](n).
+func Default[T comparable](n Node) SwitchCase[T] {
+	return SwitchCase[T]{node: n, isDefault: true}
+}
+
+// Lazy returns a Node that calls fn to build the Node to render each time it's rendered, and only then.
+// Useful for expensive parts of the tree that aren't always rendered, like behind If with a false condition.
+// The Node is placed Outside, so fn must not return attributes.
+func Lazy(fn func() Node) Node {
+	return lazy(fn)
+}
+
+type lazy func() Node
+
+func (l lazy) Render() string {
+	return renderString(l)
+}
+
+// RenderTo satisfies Renderer.
+func (l lazy) RenderTo(w io.Writer) error {
+	return l.RenderContext(writerContext(w), w)
+}
+
+// RenderContext satisfies ContextNode.
+func (l lazy) RenderContext(ctx context.Context, w io.Writer) error {
+	return RenderContext(ctx, w, l())
+}
+
+func (l lazy) Place() Placement {
+	return Outside
+}
+
+// String satisfies fmt.Stringer.
+func (l lazy) String() string {
+	return l.Render()
+}
+
+// Empty is a Node that renders nothing, for places where a Node is required, but there is nothing to render,
+// like a branch of a conditional. It doesn't keep elements from being self-closing, like an attribute.
+// It's shared, so using it doesn't allocate.
+var Empty Node = empty{}
+
+type empty struct{}
+
+func (e empty) Render() string {
+	return ""
+}
+
+// RenderTo satisfies Renderer.
+func (e empty) RenderTo(w io.Writer) error {
+	return nil
+}
+
+func (e empty) Place() Placement {
+	return Outside
+}
+
+// String satisfies fmt.Stringer.
+func (e empty) String() string {
+	return ""
+}
+
+// WithAttrs returns n with the given attributes added, for components that decorate a Node they are given,
+// like adding "disabled" to a button while loading.
+// If n is an Element, like one created with El or a helper, the attributes are added to it, see Element.With.
+// Classes and styles are merged with the existing ones, and other attributes replace existing ones with the same name.
+// Other Nodes, like Groups, are wrapped in a "div" element with the attributes.
+func WithAttrs(n Node, attrs ...Node) Node {
+	e, ok := n.(Element)
+	if !ok {
+		return El("div", Group(attrs), n)
+	}
+	return e.With(attrs...)
+}
+
+// Keyed returns n with a "data-key" attribute with the given key, so client-side DOM diffing libraries
+// like morphdom can match elements between renders, for example items in a list.
+// The attribute is added to n if it's an Element, like one created with El or a helper.
+// Other Nodes, like Groups, are wrapped in a "div" element with the attribute.
+func Keyed(key string, n Node) Node {
+	e, ok := n.(Element)
+	if !ok {
+		return El("div", Attr("data-key", key), n)
+	}
+	return e.With(Attr("data-key", key))
+}
+
+// Map each of the items to a Node using fn, and return them in a Group, or Empty if there are no items.
+// Inside a parent element, the resulting Nodes are rendered as children of that element.
+func Map[T any](items []T, fn func(T) Node) Node {
+	if len(items) == 0 {
+		return Empty
+	}
+	nodes := make([]Node, 0, len(items))
+	for _, item := range items {
+		nodes = append(nodes, fn(item))
+	}
+	return Group(nodes)
+}
+
+// MapIndexed is like Map, but also passes the index of each item to fn.
+func MapIndexed[T any](items []T, fn func(int, T) Node) Node {
+	nodes := make([]Node, 0, len(items))
+	for i, item := range items {
+		nodes = append(nodes, fn(i, item))
+	}
+	return Group(nodes)
+}
@@ -1,8 +1,12 @@
 package gomponents_test
 
 import (
+	"bytes"
+	"context"
 	"errors"
 	"fmt"
+	"html/template"
+	"io"
 	"strings"
 	"testing"
 
@@ -17,6 +21,14 @@ func TestNodeFunc(t *testing.T) {
 			t.FailNow()
 		}
 	})
+
+	t.Run("implements Renderer", func(t *testing.T) {
+		fn := g.NodeFunc(func() string { return "hat" })
+		var b strings.Builder
+		if err := fn.RenderTo(&b); err != nil || b.String() != "hat" {
+			t.FailNow()
+		}
+	})
 }
 
 func TestAttr(t *testing.T) {
@@ -30,6 +42,47 @@ func TestAttr(t *testing.T) {
 		assert.Equal(t, ` id="hat"`, a)
 	})
 
+	t.Run("escapes the value", func(t *testing.T) {
+		a := g.Attr("title", `foo" onmouseover="alert(1)`)
+		assert.Equal(t, ` title="foo&#34; onmouseover=&#34;alert(1)"`, a)
+	})
+
+	t.Run("escapes ampersands, angle brackets, and single quotes in the value", func(t *testing.T) {
+		a := g.Attr("title", `Hats & <Caps> 'n' more`)
+		assert.Equal(t, ` title="Hats &amp; &lt;Caps&gt; &#39;n&#39; more"`, a)
+	})
+
+	t.Run("removes whitespace, quotes, and angle brackets from the name", func(t *testing.T) {
+		assert.Equal(t, `<div xonload="evil"></div>`, g.El("div", g.Attr("x onload", "evil")))
+		assert.Equal(t, `<div x-hat="y"></div>`, g.El("div", g.Attr("x-\"h'a\tt>", "y")))
+		assert.Equal(t, `<img srcscriptsrc />`, g.El("img", g.Attr("src/><script>src")))
+		assert.Equal(t, `<img data-hat />`, g.El("img", g.BoolAttr("data-hat\n=", true)))
+	})
+
+	t.Run("renders nothing for an empty name", func(t *testing.T) {
+		assert.Equal(t, `<div></div>`, g.El("div", g.Attr("", "z")))
+		assert.Equal(t, `<div id="hat"></div>`, g.El("div", g.Attr("<>", "z"), g.Attr("id", "hat"), g.NameAttr(" \n")))
+		assert.Equal(t, `<br />`, g.El("br", g.RawAttr("\"=", "z"), g.BoolAttr("", true)))
+		assert.Equal(t, ``, g.ValueAttr("", ""))
+	})
+
+	t.Run("keeps other characters in the name", func(t *testing.T) {
+		assert.Equal(t, ` @click.prevent="hat"`, g.Attr("@click.prevent", "hat"))
+		assert.Equal(t, ` :class="hat"`, g.ValueAttr(":class", "hat"))
+		assert.Equal(t, ` data-hæt`, g.NameAttr("data-hæt"))
+	})
+
+	t.Run("exposes name and value", func(t *testing.T) {
+		a := g.Attr("id", "hat").(g.Attribute)
+		if v, ok := a.Value(); a.Name() != "id" || !ok || v != "hat" {
+			t.FailNow()
+		}
+		a = g.Attr("required").(g.Attribute)
+		if _, ok := a.Value(); a.Name() != "required" || ok {
+			t.FailNow()
+		}
+	})
+
 	t.Run("panics with more than two arguments", func(t *testing.T) {
 		called := false
 		defer func() {
@@ -52,37 +105,327 @@ func TestAttr(t *testing.T) {
 	})
 }
 
+func TestWriteTo(t *testing.T) {
+	t.Run("writes nodes and returns the number of bytes written", func(t *testing.T) {
+		nodes := []g.Node{
+			g.El("div", g.Attr("class", "hat"), g.El("span", g.Text("Party & hat"))),
+			g.Attr("id", "<hat>"),
+			g.Text("Party & hat"),
+			g.Group([]g.Node{g.El("br"), g.Text("hat")}),
+		}
+		for _, n := range nodes {
+			var b strings.Builder
+			written, err := n.(io.WriterTo).WriteTo(&b)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if b.String() != n.Render() || written != int64(b.Len()) {
+				t.Fatalf("unexpected %v bytes written for %v", written, b.String())
+			}
+		}
+	})
+
+	t.Run("returns the bytes written before an error", func(t *testing.T) {
+		n := g.El("div", g.Text("hats"))
+		written, err := n.WriteTo(&limitedWriter{n: 2})
+		if err == nil || written == 0 || written >= int64(len(n.Render())) {
+			t.Fatalf("expected an error after some bytes, got %v and %v", written, err)
+		}
+	})
+}
+
+func TestNameAttr(t *testing.T) {
+	t.Run("renders just the name", func(t *testing.T) {
+		assert.Equal(t, ` required`, g.NameAttr("required"))
+	})
+}
+
+func TestValueAttr(t *testing.T) {
+	t.Run("renders the name and value", func(t *testing.T) {
+		assert.Equal(t, ` id="hat"`, g.ValueAttr("id", "hat"))
+	})
+
+	t.Run("renders an empty value", func(t *testing.T) {
+		assert.Equal(t, ` value=""`, g.ValueAttr("value", ""))
+	})
+
+	t.Run("escapes the value", func(t *testing.T) {
+		assert.Equal(t, ` title="&lt;hat&gt;"`, g.ValueAttr("title", "<hat>"))
+	})
+}
+
+func TestAttrf(t *testing.T) {
+	t.Run("renders the name and interpolated value", func(t *testing.T) {
+		assert.Equal(t, `<div style="width:42px"></div>`, g.El("div", g.Attrf("style", "width:%vpx", 42)))
+	})
+
+	t.Run("escapes the interpolated value", func(t *testing.T) {
+		assert.Equal(t, ` title="&#34;Party&#34; &amp; &lt;hats&gt;"`, g.Attrf("title", "%q & %v", "Party", "<hats>"))
+	})
+}
+
+func TestRawAttr(t *testing.T) {
+	t.Run("renders the value as-is", func(t *testing.T) {
+		assert.Equal(t, `<a title="Hats &amp; caps">hat</a>`, g.El("a", g.RawAttr("title", "Hats &amp; caps"), g.Text("hat")))
+	})
+
+	t.Run("escapes the other value when merged with a normal attribute", func(t *testing.T) {
+		assert.Equal(t, `<div class="a&amp;b c&amp;d"></div>`, g.El("div", g.RawAttr("class", "a&amp;b"), g.Attr("class", "c&d")))
+		assert.Equal(t, `<div class="c&amp;d a&amp;b"></div>`, g.El("div", g.Attr("class", "c&d"), g.RawAttr("class", "a&amp;b")))
+	})
+}
+
+func TestAttrs(t *testing.T) {
+	t.Run("renders the attributes sorted by name", func(t *testing.T) {
+		n := g.El("input", g.Attrs(map[string]string{"type": "text", "name": "hat", "data-hat": "<party>"}))
+		assert.Equal(t, `<input data-hat="&lt;party&gt;" name="hat" type="text" />`, n)
+	})
+
+	t.Run("renders empty values as name-only attributes", func(t *testing.T) {
+		assert.Equal(t, `<input required />`, g.El("input", g.Attrs(map[string]string{"required": ""})))
+	})
+
+	t.Run("renders nothing for an empty map", func(t *testing.T) {
+		assert.Equal(t, `<br />`, g.El("br", g.Attrs(nil)))
+	})
+}
+
+func TestBoolAttr(t *testing.T) {
+	t.Run("renders just the name if present", func(t *testing.T) {
+		assert.Equal(t, `<input disabled />`, g.El("input", g.BoolAttr("disabled", true)))
+	})
+
+	t.Run("renders nothing if not present", func(t *testing.T) {
+		assert.Equal(t, `<input type="checkbox" />`, g.El("input", g.Attr("type", "checkbox"), g.BoolAttr("checked", false)))
+		assert.Equal(t, ``, g.BoolAttr("checked", false))
+	})
+
+	t.Run("does not keep foreign elements from self-closing if not present", func(t *testing.T) {
+		assert.Equal(t, `<circle />`, g.ForeignEl("circle", g.BoolAttr("hidden", false)))
+	})
+}
+
 type outsider struct{}
 
 func (o outsider) Render() string {
 	return "outsider"
 }
 
+type insider struct{}
+
+func (i insider) Render() string {
+	return " insider"
+}
+
+func (i insider) Place() g.Placement {
+	return g.Inside
+}
+
 func TestEl(t *testing.T) {
-	t.Run("renders an empty element if no children given", func(t *testing.T) {
+	t.Run("renders an element with a closing tag if no children given", func(t *testing.T) {
 		e := g.El("div")
-		assert.Equal(t, "<div />", e)
+		assert.Equal(t, "<div></div>", e)
 	})
 
-	t.Run("renders an empty element if only attributes given as children", func(t *testing.T) {
+	t.Run("renders an element with a closing tag if only attributes given as children", func(t *testing.T) {
 		e := g.El("div", g.Attr("class", "hat"))
-		assert.Equal(t, `<div class="hat" />`, e)
+		assert.Equal(t, `<div class="hat"></div>`, e)
+	})
+
+	t.Run("renders an element with a closing tag if all children are inside or render nothing", func(t *testing.T) {
+		e := g.El("div", g.Group([]g.Node{g.Attr("class", "hat")}), insider{}, g.If(false, g.El("span")), g.BoolAttr("hidden", false))
+		assert.Equal(t, `<div class="hat" insider></div>`, e)
+		assert.Equal(t, `<span id="hat"></span>`, g.El("span", g.Attr("id", "hat")))
 	})
 
 	t.Run("renders an element, attributes, and element children", func(t *testing.T) {
 		e := g.El("div", g.Attr("class", "hat"), g.El("span"))
-		assert.Equal(t, `<div class="hat"><span /></div>`, e)
+		assert.Equal(t, `<div class="hat"><span></span></div>`, e)
 	})
 
 	t.Run("renders attributes at the correct place regardless of placement in parameter list", func(t *testing.T) {
 		e := g.El("div", g.El("span"), g.Attr("class", "hat"))
-		assert.Equal(t, `<div class="hat"><span /></div>`, e)
+		assert.Equal(t, `<div class="hat"><span></span></div>`, e)
+	})
+
+	t.Run("renders void elements self-closing", func(t *testing.T) {
+		e := g.El("br", g.Attr("class", "hat"))
+		assert.Equal(t, `<br class="hat" />`, e)
+	})
+
+	t.Run("does not render outside children of void elements", func(t *testing.T) {
+		e := g.El("img", g.Attr("src", "hat.png"), g.Text("hat"))
+		assert.Equal(t, `<img src="hat.png" />`, e)
+	})
+
+	t.Run("renders text in script and style elements unescaped", func(t *testing.T) {
+		e := g.El("script", g.Text("if (hat < 1 && party) { wear('partyhat') }"))
+		assert.Equal(t, `<script>if (hat < 1 && party) { wear('partyhat') }</script>`, e)
+
+		e = g.El("style", g.Group([]g.Node{g.Textf("a > b::after { content: %q }", "&")}))
+		assert.Equal(t, `<style>a > b::after { content: "&" }</style>`, e)
+	})
+
+	t.Run("escapes end tags in text in script and style elements", func(t *testing.T) {
+		e := g.El("script", g.Text("var hat = '</script><img src=x onerror=alert(1)>'; var party = '</SCRIPT '; var b = '</b>'"))
+		assert.Equal(t, `<script>var hat = '<\/script><img src=x onerror=alert(1)>'; var party = '<\/SCRIPT '; var b = '</b>'</script>`, e)
+
+		e = g.El("style", g.Text("a::after { content: '</style><script>alert(1)</script>' }"))
+		assert.Equal(t, `<style>a::after { content: '<\/style><script>alert(1)</script>' }</style>`, e)
+	})
+
+	t.Run("renders text in textarea and title elements escaped", func(t *testing.T) {
+		e := g.El("textarea", g.Text("hat < partyhat & turtlehat"))
+		assert.Equal(t, `<textarea>hat &lt; partyhat &amp; turtlehat</textarea>`, e)
+
+		e = g.El("title", g.Text("hat < partyhat & turtlehat"))
+		assert.Equal(t, `<title>hat &lt; partyhat &amp; turtlehat</title>`, e)
+	})
+
+	t.Run("merges class attributes with spaces", func(t *testing.T) {
+		e := g.El("div", g.Attr("class", "card"), g.Attr("id", "hat"), g.Group([]g.Node{g.Attr("class", " highlight ")}))
+		assert.Equal(t, `<div class="card highlight" id="hat"></div>`, e)
+	})
+
+	t.Run("merges style attributes with semicolons", func(t *testing.T) {
+		e := g.El("div", g.Attr("style", "color:red;"), g.Attr("style", "font-weight:bold"))
+		assert.Equal(t, `<div style="color:red;font-weight:bold"></div>`, e)
+	})
+
+	t.Run("uses the last of other attributes with the same name", func(t *testing.T) {
+		e := g.El("input", g.Attr("type", "text"), g.Attr("name", "hat"), g.Attr("type", "email"), g.Attr("required"))
+		assert.Equal(t, `<input type="email" name="hat" required />`, e)
+	})
+
+	t.Run("keeps other inside nodes when merging", func(t *testing.T) {
+		e := g.El("div", g.Attr("class", "card"), insider{}, g.Attr("class", "highlight"))
+		assert.Equal(t, `<div class="card highlight" insider></div>`, e)
+	})
+
+	t.Run("skips nil children", func(t *testing.T) {
+		var n g.Node
+		assert.Equal(t, `<div class="hat"><span></span></div>`, g.El("div", n, g.Attr("class", "hat"), g.El("span", nil), g.Group([]g.Node{nil})))
+		assert.Equal(t, `<circle />`, g.ForeignEl("circle", nil))
 	})
 
 	t.Run("renders outside if node does not implement placer", func(t *testing.T) {
 		e := g.El("div", outsider{})
 		assert.Equal(t, `<div>outsider</div>`, e)
 	})
+
+	t.Run("renders to a writer the same as to a string", func(t *testing.T) {
+		e := g.El("div", g.Attr("class", "hat"), g.El("span", g.Text("party")), outsider{}, g.Attr("id", "partyhat"))
+		var b strings.Builder
+		if err := e.RenderTo(&b); err != nil {
+			t.FailNow()
+		}
+		if b.String() != `<div class="hat" id="partyhat"><span>party</span>outsider</div>` || b.String() != e.Render() {
+			t.FailNow()
+		}
+	})
+
+	t.Run("implements fmt.Stringer", func(t *testing.T) {
+		e := g.El("div")
+		if fmt.Sprintf("%v", e) != "<div></div>" {
+			t.FailNow()
+		}
+	})
+}
+
+func TestElement(t *testing.T) {
+	e := g.El("div", g.Attr("class", "card"), g.El("span"), g.Group([]g.Node{g.Attr("id", "hat"), g.Attr("class", "highlight")}))
+
+	t.Run("has a name", func(t *testing.T) {
+		if e.Name() != "div" {
+			t.FailNow()
+		}
+	})
+
+	t.Run("returns a copy with more children", func(t *testing.T) {
+		assert.Equal(t, `<div class="card highlight party" id="hat"><span></span><p></p></div>`, e.With(g.El("p"), g.Attr("class", "party")))
+		assert.Equal(t, `<div class="card highlight" id="hat"><span></span></div>`, e)
+		assert.Equal(t, `<circle r="5" />`, g.ForeignEl("circle").With(g.Attr("r", "5")))
+	})
+
+	t.Run("has children", func(t *testing.T) {
+		children := e.Children()
+		if len(children) != 3 {
+			t.Fatalf("expected 3 children, got %v", len(children))
+		}
+		if child, ok := children[1].(g.Element); !ok || child.Name() != "span" {
+			t.Fatalf("unexpected child %v", children[1])
+		}
+		children[0] = nil
+		if e.Children()[0] == nil {
+			t.Fatal("changed the element children")
+		}
+	})
+
+	t.Run("has attributes, including in groups", func(t *testing.T) {
+		var names []string
+		for _, a := range e.Attributes() {
+			names = append(names, a.Name())
+		}
+		if strings.Join(names, " ") != "class id class" {
+			t.Fatalf("unexpected attributes %v", names)
+		}
+	})
+
+	t.Run("has merged attributes by name", func(t *testing.T) {
+		a, ok := e.Attribute("class")
+		if v, _ := a.Value(); !ok || v != "card highlight" {
+			t.Fatalf("unexpected class attribute %v", a)
+		}
+		if _, ok := e.Attribute("style"); ok {
+			t.Fatal("found style attribute")
+		}
+	})
+}
+
+func TestForeignEl(t *testing.T) {
+	t.Run("renders self-closing without outside children", func(t *testing.T) {
+		e := g.ForeignEl("circle", g.Attr("r", "5"))
+		assert.Equal(t, `<circle r="5" />`, e)
+	})
+
+	t.Run("renders a closing tag with outside children", func(t *testing.T) {
+		e := g.ForeignEl("g", g.ForeignEl("circle"))
+		assert.Equal(t, `<g><circle /></g>`, e)
+	})
+
+	t.Run("does not apply html void and raw text element rules", func(t *testing.T) {
+		e := g.ForeignEl("link", g.Text("https://example.com/?hat=1&party=2"))
+		assert.Equal(t, `<link>https://example.com/?hat=1&amp;party=2</link>`, e)
+
+		e = g.ForeignEl("script", g.Text("a < b"))
+		assert.Equal(t, `<script>a &lt; b</script>`, e)
+	})
+}
+
+func TestIsVoidElement(t *testing.T) {
+	t.Run("returns true for void elements", func(t *testing.T) {
+		for _, name := range []string{"area", "base", "br", "col", "embed", "hr", "img", "input", "link", "meta",
+			"param", "source", "track", "wbr"} {
+			if !g.IsVoidElement(name) {
+				t.Fatalf("expected %v to be void", name)
+			}
+		}
+	})
+
+	t.Run("returns false for other elements", func(t *testing.T) {
+		for _, name := range []string{"div", "script", "span"} {
+			if g.IsVoidElement(name) {
+				t.Fatalf("expected %v to not be void", name)
+			}
+		}
+	})
+}
+
+func TestRegisterVoidElement(t *testing.T) {
+	t.Run("renders registered elements self-closing", func(t *testing.T) {
+		g.RegisterVoidElement("hat-icon")
+		assert.Equal(t, `<hat-icon name="party" />`, g.El("hat-icon", g.Attr("name", "party")))
+	})
 }
 
 func TestText(t *testing.T) {
@@ -90,6 +433,21 @@ func TestText(t *testing.T) {
 		e := g.Text("<div />")
 		assert.Equal(t, "&lt;div /&gt;", e)
 	})
+
+	t.Run("writes escaped text", func(t *testing.T) {
+		var b strings.Builder
+		if err := g.Write(&b, g.Text("<div />")); err != nil || b.String() != "&lt;div /&gt;" {
+			t.FailNow()
+		}
+	})
+
+	t.Run("writes text escaped like template.HTMLEscapeString", func(t *testing.T) {
+		s := "\"Hats\" & 'caps' <are> \x00 great"
+		var b strings.Builder
+		if err := g.Write(&b, g.Text(s)); err != nil || b.String() != template.HTMLEscapeString(s) {
+			t.Fatalf("unexpected output %v", b.String())
+		}
+	})
 }
 
 func TestTextf(t *testing.T) {
@@ -106,10 +464,135 @@ func TestRaw(t *testing.T) {
 	})
 }
 
+var errWrite = errors.New("don't want to write")
+
+func TestRawf(t *testing.T) {
+	t.Run("renders interpolated and raw text", func(t *testing.T) {
+		assert.Equal(t, `<svg width="100"><!-- hat --></svg>`, g.Rawf("<svg width=%q><!-- %v --></svg>", "100", "hat"))
+	})
+}
+
+func TestComment(t *testing.T) {
+	t.Run("renders a comment", func(t *testing.T) {
+		e := g.Comment("hat")
+		assert.Equal(t, "<!-- hat -->", e)
+	})
+
+	t.Run("escapes the end of comment sequences", func(t *testing.T) {
+		e := g.Comment("hat --> <script> --!> party")
+		assert.Equal(t, "<!-- hat --&gt; <script> --!&gt; party -->", e)
+	})
+
+	t.Run("is placed outside in an element", func(t *testing.T) {
+		e := g.El("div", g.Comment("hat"), g.Attr("id", "partyhat"))
+		assert.Equal(t, `<div id="partyhat"><!-- hat --></div>`, e)
+	})
+}
+
+func TestRawComment(t *testing.T) {
+	t.Run("renders an unescaped comment", func(t *testing.T) {
+		e := g.RawComment("[if lt IE 9]><script src=\"hat.js\"></script><![endif]")
+		assert.Equal(t, `<!--[if lt IE 9]><script src="hat.js"></script><![endif]-->`, e)
+	})
+}
+
+func TestMSOConditional(t *testing.T) {
+	t.Run("wraps the content in a conditional comment", func(t *testing.T) {
+		n := g.El("td", g.MSOConditional("gte mso 9", g.El("v:rect", g.Attr("fill", "true"), g.Text("Hat & co"))))
+		assert.Equal(t, `<td><!--[if gte mso 9]><v:rect fill="true">Hat &amp; co</v:rect><![endif]--></td>`, n)
+	})
+}
+
+func TestNotMSOConditional(t *testing.T) {
+	t.Run("wraps the content in a negated conditional comment", func(t *testing.T) {
+		n := g.El("td", g.NotMSOConditional(g.El("div", g.Text("Hat"))))
+		assert.Equal(t, `<td><!--[if !mso]><!--><div>Hat</div><!--<![endif]--></td>`, n)
+	})
+}
+
+func TestHTML(t *testing.T) {
+	t.Run("returns rendered html that html/template does not escape", func(t *testing.T) {
+		tmpl := template.Must(template.New("").Parse(`<body>{{.}}</body>`))
+		var b strings.Builder
+		if err := tmpl.Execute(&b, g.HTML(g.El("div", g.Text("Hats & caps")))); err != nil {
+			t.Fatal(err)
+		}
+		if b.String() != `<body><div>Hats &amp; caps</div></body>` {
+			t.Fatalf("unexpected output %v", b.String())
+		}
+	})
+}
+
+func TestRenderBytes(t *testing.T) {
+	t.Run("renders the node to bytes", func(t *testing.T) {
+		n := g.El("div", g.Attr("class", "hat"), g.Text("Party & turtle"))
+		if string(g.RenderBytes(n)) != n.Render() {
+			t.FailNow()
+		}
+	})
+
+	t.Run("renders nodes not implementing renderer", func(t *testing.T) {
+		if string(g.RenderBytes(outsider{})) != "outsider" {
+			t.FailNow()
+		}
+	})
+
+	t.Run("returns bytes that are not changed by later renders", func(t *testing.T) {
+		b := g.RenderBytes(g.Text("partyhat"))
+		_ = g.RenderBytes(g.Text("turtlehat"))
+		if string(b) != "partyhat" {
+			t.FailNow()
+		}
+	})
+}
+
 type erroringWriter struct{}
 
 func (w *erroringWriter) Write(p []byte) (n int, err error) {
-	return 0, errors.New("don't want to write")
+	return 0, errWrite
+}
+
+// limitedWriter accepts n writes and errors on the ones after that.
+type limitedWriter struct {
+	n int
+}
+
+func (w *limitedWriter) Write(p []byte) (int, error) {
+	if w.n == 0 {
+		return 0, errWrite
+	}
+	w.n--
+	return len(p), nil
+}
+
+func TestRenderBuffer(t *testing.T) {
+	t.Run("appends to the buffer", func(t *testing.T) {
+		b := bytes.NewBufferString("<!doctype html>")
+		if err := g.RenderBuffer(b, g.El("div", g.Text("hat"))); err != nil {
+			t.Fatal(err)
+		}
+		if b.String() != "<!doctype html><div>hat</div>" {
+			t.Fatalf("unexpected output %v", b.String())
+		}
+	})
+
+	t.Run("does not allocate when reusing the buffer", func(t *testing.T) {
+		var n g.Node = g.El("div", g.Attr("class", "hat"), g.El("span", g.Text("Party & hat")))
+		var b bytes.Buffer
+		allocs := testing.AllocsPerRun(100, func() {
+			b.Reset()
+			_ = g.RenderBuffer(&b, n)
+		})
+		if allocs != 0 {
+			t.Fatalf("expected no allocations, got %v", allocs)
+		}
+	})
+
+	t.Run("errors on render error", func(t *testing.T) {
+		if err := g.RenderBuffer(&bytes.Buffer{}, g.El("div", erroringNode{})); err == nil {
+			t.FailNow()
+		}
+	})
 }
 
 func TestWrite(t *testing.T) {
@@ -125,6 +608,74 @@ func TestWrite(t *testing.T) {
 		}
 	})
 
+	t.Run("writes nodes not implementing renderer", func(t *testing.T) {
+		var b strings.Builder
+		err := g.Write(&b, outsider{})
+		if err != nil || b.String() != "outsider" {
+			t.FailNow()
+		}
+	})
+
+	t.Run("writes multiple nodes one after the other", func(t *testing.T) {
+		var b strings.Builder
+		err := g.Write(&b, g.El("div", g.Attr("id", "hat")), g.Text("hat"), g.El("span"))
+		if err != nil || b.String() != `<div id="hat"></div>hat<span></span>` {
+			t.Fatalf("unexpected output %v, %v", b.String(), err)
+		}
+	})
+
+	t.Run("writes nothing for nil nodes", func(t *testing.T) {
+		var b strings.Builder
+		if err := g.Write(&b, nil); err != nil || b.String() != "" {
+			t.FailNow()
+		}
+		if err := g.Write(&b, nil, g.Text("hat"), nil); err != nil || b.String() != "hat" {
+			t.FailNow()
+		}
+	})
+
+	t.Run("writes nothing without nodes", func(t *testing.T) {
+		var b strings.Builder
+		if err := g.Write(&b); err != nil || b.String() != "" {
+			t.FailNow()
+		}
+	})
+
+	t.Run("stops writing multiple nodes at the first error", func(t *testing.T) {
+		called := false
+		err := g.Write(&limitedWriter{n: 3}, g.El("div"), g.NodeFunc(func() string {
+			called = true
+			return "hat"
+		}))
+		if !errors.Is(err, errWrite) || called {
+			t.FailNow()
+		}
+	})
+
+	t.Run("writes to all writers of an io.MultiWriter", func(t *testing.T) {
+		e := g.El("div", g.Attr("class", "hat"), g.Text("Party & hat"))
+		var b, log strings.Builder
+		if err := g.Write(io.MultiWriter(&b, &log), e); err != nil {
+			t.Fatal(err)
+		}
+		if b.String() != e.Render() || log.String() != e.Render() {
+			t.Fatalf("unexpected output %v and %v", b.String(), log.String())
+		}
+	})
+
+	t.Run("stops writing to all writers of an io.MultiWriter when one fails", func(t *testing.T) {
+		var b strings.Builder
+		lw := &limitedWriter{n: 2}
+		err := g.Write(io.MultiWriter(&b, lw), g.El("div", g.Text("hat")))
+		if !errors.Is(err, errWrite) {
+			t.Fatalf("expected write error, got %v", err)
+		}
+		// The failing write reaches the first writer, but nothing after it
+		if b.String() != "<div>" {
+			t.Fatalf("unexpected output %v", b.String())
+		}
+	})
+
 	t.Run("errors on write error", func(t *testing.T) {
 		e := g.El("div")
 		err := g.Write(&erroringWriter{}, e)
@@ -132,26 +683,445 @@ func TestWrite(t *testing.T) {
 			t.FailNow()
 		}
 	})
+
+	t.Run("wraps the write error with the name of the failing element", func(t *testing.T) {
+		e := g.El("div", g.El("span", g.Text("hat")))
+		err := g.Write(&limitedWriter{n: 4}, e)
+		if !errors.Is(err, errWrite) {
+			t.FailNow()
+		}
+		if err.Error() != "cannot render element span: don't want to write" {
+			t.Fatalf("unexpected error message %v", err)
+		}
+	})
+
+	t.Run("stops rendering at the first error", func(t *testing.T) {
+		called := false
+		e := g.El("div", g.El("span"), g.NodeFunc(func() string {
+			called = true
+			return "hat"
+		}))
+		err := g.Write(&limitedWriter{n: 3}, e)
+		if err == nil || called {
+			t.FailNow()
+		}
+	})
+}
+
+type hatKey struct{}
+
+// hatFromContext renders the hat in the render context.
+type hatFromContext struct{}
+
+func (h hatFromContext) Render() string {
+	return "no hat"
+}
+
+func (h hatFromContext) RenderContext(ctx context.Context, w io.Writer) error {
+	hat, _ := ctx.Value(hatKey{}).(string)
+	return g.Write(w, g.Text(hat))
+}
+
+// withHat renders its children with the given hat in the render context.
+type withHat struct {
+	hat      string
+	children []g.Node
+}
+
+func (h withHat) Render() string {
+	return "no hat"
+}
+
+func (h withHat) RenderContext(ctx context.Context, w io.Writer) error {
+	return g.RenderContext(context.WithValue(ctx, hatKey{}, h.hat), w, g.Group(h.children))
+}
+
+func TestRenderContext(t *testing.T) {
+	t.Run("passes the context to nodes in elements and groups", func(t *testing.T) {
+		ctx := context.WithValue(context.Background(), hatKey{}, "partyhat")
+		n := g.El("div", g.Attr("class", "hat"), g.Group([]g.Node{g.El("span", hatFromContext{})}), hatFromContext{})
+		var b strings.Builder
+		if err := g.RenderContext(ctx, &b, n); err != nil {
+			t.Fatal(err)
+		}
+		if b.String() != `<div class="hat"><span>partyhat</span>partyhat</div>` {
+			t.Fatalf("unexpected output %v", b.String())
+		}
+	})
+
+	t.Run("passes a changed context only to the children of the node changing it", func(t *testing.T) {
+		ctx := context.WithValue(context.Background(), hatKey{}, "partyhat")
+		n := g.El("div", withHat{hat: "turtlehat", children: []g.Node{g.El("span", hatFromContext{})}}, hatFromContext{})
+		var b strings.Builder
+		if err := g.RenderContext(ctx, &b, n); err != nil {
+			t.Fatal(err)
+		}
+		if b.String() != `<div><span>turtlehat</span>partyhat</div>` {
+			t.Fatalf("unexpected output %v", b.String())
+		}
+	})
+
+	t.Run("renders with a background context when rendering without one", func(t *testing.T) {
+		assert.Equal(t, "<div></div>", g.El("div", hatFromContext{}))
+	})
+
+	t.Run("renders nodes that are not context nodes", func(t *testing.T) {
+		var b strings.Builder
+		if err := g.RenderContext(context.Background(), &b, outsider{}); err != nil || b.String() != "outsider" {
+			t.FailNow()
+		}
+	})
+
+	t.Run("stops rendering at the next element when the context is cancelled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		n := g.El("ul",
+			g.El("li", g.Text("Partyhat")),
+			g.NodeFunc(func() string {
+				cancel()
+				return ""
+			}),
+			g.El("li", g.Text("Turtlehat")),
+		)
+		var b strings.Builder
+		err := g.RenderContext(ctx, &b, n)
+		if !errors.Is(err, context.Canceled) {
+			t.Fatalf("expected context.Canceled, got %v", err)
+		}
+		if err.Error() != "cannot render element li: context canceled" {
+			t.Fatalf("unexpected error message %v", err)
+		}
+		if b.String() != "<ul><li>Partyhat</li>" {
+			t.Fatalf("unexpected output %v", b.String())
+		}
+	})
+
+	t.Run("does not render with an expired context", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), 0)
+		defer cancel()
+		var b strings.Builder
+		if err := g.RenderContext(ctx, &b, g.El("div")); !errors.Is(err, context.DeadlineExceeded) || b.String() != "" {
+			t.Fatalf("expected context.DeadlineExceeded and no output, got %v and %v", err, b.String())
+		}
+	})
 }
 
 func TestGroup(t *testing.T) {
 	t.Run("groups multiple nodes into one", func(t *testing.T) {
 		children := []g.Node{g.El("div", g.Attr("id", "hat")), g.El("div")}
 		e := g.El("div", g.Attr("class", "foo"), g.El("div"), g.Group(children))
-		assert.Equal(t, `<div class="foo"><div /><div id="hat" /><div /></div>`, e)
+		assert.Equal(t, `<div class="foo"><div></div><div id="hat"></div><div></div></div>`, e)
 	})
 
-	t.Run("panics on direct render", func(t *testing.T) {
-		e := g.Group(nil)
-		panicced := false
-		defer func() {
-			if err := recover(); err != nil {
-				panicced = true
-			}
-		}()
-		e.Render()
-		if !panicced {
+	t.Run("renders children directly when rendered on its own", func(t *testing.T) {
+		e := g.Group([]g.Node{g.El("div", g.Attr("id", "hat")), g.Text("partyhat"), g.El("span")})
+		assert.Equal(t, `<div id="hat"></div>partyhat<span></span>`, e)
+	})
+
+	t.Run("renders nothing when empty", func(t *testing.T) {
+		assert.Equal(t, "", g.Group(nil))
+	})
+
+	t.Run("flattens nested groups and keeps placement", func(t *testing.T) {
+		n := g.Group([]g.Node{g.Text("party"), g.Group([]g.Node{g.Group([]g.Node{g.Attr("class", "hat"), g.Text("turtle")})}), g.El("span")})
+		var visited []string
+		g.Walk(n, func(n g.Node) bool {
+			visited = append(visited, n.Render())
+			return true
+		})
+		if strings.Join(visited, ",") != `party, class="hat",turtle,<span></span>` {
+			t.Fatalf("unexpected visits %v", visited)
+		}
+		assert.Equal(t, `<div class="hat">partyturtle<span></span></div>`, g.El("div", n))
+	})
+
+	t.Run("can be written on its own", func(t *testing.T) {
+		var b strings.Builder
+		err := g.Write(&b, g.Group([]g.Node{g.El("div"), g.El("span")}))
+		if err != nil || b.String() != "<div></div><span></span>" {
 			t.FailNow()
 		}
 	})
 }
+
+func TestJoin(t *testing.T) {
+	t.Run("puts the separator between the nodes", func(t *testing.T) {
+		n := g.El("p", g.Join(g.Text(", "), g.El("b", g.Text("party")), g.Text("turtle"), g.El("i", g.Text("top"))))
+		assert.Equal(t, "<p><b>party</b>, turtle, <i>top</i></p>", n)
+	})
+
+	t.Run("renders just the node if there is one", func(t *testing.T) {
+		assert.Equal(t, "<p>hat</p>", g.El("p", g.Join(g.Text(", "), g.Text("hat"))))
+	})
+
+	t.Run("skips nil nodes", func(t *testing.T) {
+		assert.Equal(t, "<p>party turtle</p>", g.El("p", g.Join(g.Space(), nil, g.Text("party"), nil, g.Text("turtle"))))
+	})
+
+	t.Run("keeps attributes inside", func(t *testing.T) {
+		assert.Equal(t, `<p class="hat">party turtle</p>`, g.El("p", g.Join(g.Space(), g.Attr("class", "hat"), g.Text("party"), g.Text("turtle"))))
+	})
+}
+
+func TestSpace(t *testing.T) {
+	t.Run("renders a space", func(t *testing.T) {
+		assert.Equal(t, "<p>Hat: <b>party</b></p>", g.El("p", g.Text("Hat:"), g.Space(), g.El("b", g.Text("party"))))
+	})
+}
+
+func TestNBSP(t *testing.T) {
+	t.Run("renders a non-breaking space entity", func(t *testing.T) {
+		assert.Equal(t, "<p>party&nbsp;hat</p>", g.El("p", g.Text("party"), g.NBSP(), g.Text("hat")))
+	})
+}
+
+func TestIf(t *testing.T) {
+	t.Run("returns node if condition is true", func(t *testing.T) {
+		n := g.El("div", g.If(true, g.El("span")))
+		assert.Equal(t, "<div><span></span></div>", n)
+	})
+
+	t.Run("returns nothing if condition is false", func(t *testing.T) {
+		n := g.El("div", g.If(false, g.El("span")))
+		assert.Equal(t, "<div></div>", n)
+	})
+
+	t.Run("renders the empty string on its own if condition is false", func(t *testing.T) {
+		assert.Equal(t, "", g.If(false, g.El("span")))
+	})
+
+	t.Run("works with attributes", func(t *testing.T) {
+		n := g.El("input", g.If(true, g.Attr("required")), g.If(false, g.Attr("disabled")))
+		assert.Equal(t, "<input required />", n)
+	})
+}
+
+func TestIfElse(t *testing.T) {
+	t.Run("returns first node if condition is true", func(t *testing.T) {
+		n := g.El("div", g.IfElse(true, g.El("span"), g.El("p")))
+		assert.Equal(t, "<div><span></span></div>", n)
+	})
+
+	t.Run("returns second node if condition is false", func(t *testing.T) {
+		n := g.El("div", g.IfElse(false, g.El("span"), g.El("p")))
+		assert.Equal(t, "<div><p></p></div>", n)
+	})
+}
+
+func TestIfFunc(t *testing.T) {
+	t.Run("returns the built node if condition is true", func(t *testing.T) {
+		assert.Equal(t, `<div><span>hat</span></div>`, g.El("div", g.IfFunc(true, func() g.Node { return g.El("span", g.Text("hat")) })))
+	})
+
+	t.Run("does not build the node if condition is false", func(t *testing.T) {
+		var hat *string
+		n := g.El("div", g.IfFunc(hat != nil, func() g.Node { return g.Text(*hat) }))
+		assert.Equal(t, `<div></div>`, n)
+	})
+}
+
+func TestIfElseFunc(t *testing.T) {
+	t.Run("builds only the node for the condition", func(t *testing.T) {
+		var hat *string
+		n := g.IfElseFunc(hat != nil, func() g.Node { return g.Text(*hat) }, func() g.Node { return g.Text("No hat") })
+		assert.Equal(t, `No hat`, n)
+		partyhat := "Partyhat"
+		hat = &partyhat
+		n = g.IfElseFunc(hat != nil, func() g.Node { return g.Text(*hat) }, func() g.Node { panic("built") })
+		assert.Equal(t, `Partyhat`, n)
+	})
+}
+
+func TestSwitch(t *testing.T) {
+	type hat string
+
+	badge := func(h hat) g.Node {
+		return g.Switch(h,
+			g.Case[hat]("party", g.El("span", g.Text("Party!"))),
+			g.Default[hat](g.El("span", g.Text("Just a hat"))),
+			g.Case[hat]("turtle", g.El("span", g.Text("Turtle!"))),
+		)
+	}
+
+	t.Run("returns the node of the matching case", func(t *testing.T) {
+		assert.Equal(t, "<span>Party!</span>", badge("party"))
+		assert.Equal(t, "<span>Turtle!</span>", badge("turtle"))
+	})
+
+	t.Run("returns the default node if no case matches", func(t *testing.T) {
+		assert.Equal(t, "<span>Just a hat</span>", badge("top"))
+	})
+
+	t.Run("returns the node of the first matching case", func(t *testing.T) {
+		assert.Equal(t, "<p>one</p>", g.Switch(1, g.Case(1, g.El("p", g.Text("one"))), g.Case(1, g.El("p", g.Text("uno")))))
+	})
+
+	t.Run("returns an empty node if no case matches and there is no default", func(t *testing.T) {
+		assert.Equal(t, "<div></div>", g.El("div", g.Switch(3, g.Case(1, g.Text("one")), g.Case(2, g.Text("two")))))
+	})
+}
+
+func TestLazy(t *testing.T) {
+	t.Run("builds the node once per render", func(t *testing.T) {
+		var builds int
+		n := g.El("div", g.Lazy(func() g.Node {
+			builds++
+			return g.El("span", g.Text("hat"))
+		}))
+		if builds != 0 {
+			t.Fatalf("expected no builds before render, got %v", builds)
+		}
+		assert.Equal(t, "<div><span>hat</span></div>", n)
+		assert.Equal(t, "<div><span>hat</span></div>", n)
+		if builds != 2 {
+			t.Fatalf("expected two builds, got %v", builds)
+		}
+	})
+
+	t.Run("does not build the node if not rendered", func(t *testing.T) {
+		n := g.El("div", g.If(false, g.Lazy(func() g.Node {
+			t.Fatal("built the node")
+			return nil
+		})))
+		assert.Equal(t, "<div></div>", n)
+	})
+
+	t.Run("passes the render context on", func(t *testing.T) {
+		ctx := context.WithValue(context.Background(), hatKey{}, "partyhat")
+		var b strings.Builder
+		if err := g.RenderContext(ctx, &b, g.Lazy(func() g.Node { return g.El("p", hatFromContext{}) })); err != nil {
+			t.Fatal(err)
+		}
+		if b.String() != "<p>partyhat</p>" {
+			t.Fatalf("unexpected output %v", b.String())
+		}
+	})
+}
+
+func TestEmpty(t *testing.T) {
+	t.Run("renders nothing", func(t *testing.T) {
+		assert.Equal(t, "<div></div>", g.El("div", g.Empty))
+		assert.Equal(t, "", g.Empty)
+	})
+
+	t.Run("does not keep elements from being self-closing", func(t *testing.T) {
+		assert.Equal(t, "<circle />", g.ForeignEl("circle", g.Empty, g.If(false, g.Text("hat"))))
+	})
+
+	t.Run("is returned by conditional helpers with nothing to render", func(t *testing.T) {
+		for _, n := range []g.Node{g.If(false, g.Text("hat")), g.BoolAttr("hidden", false), g.Map([]string{}, g.Text)} {
+			if n != g.Empty {
+				t.Fatalf("expected Empty, got %#v", n)
+			}
+		}
+	})
+}
+
+func TestWithAttrs(t *testing.T) {
+	button := func() g.Node {
+		return g.El("button", g.Attr("class", "btn"), g.Attr("type", "button"), g.Text("Get hat"))
+	}
+
+	t.Run("adds the attributes to an element", func(t *testing.T) {
+		n := g.WithAttrs(button(), g.Attr("disabled"), g.Attr("class", "loading"), g.Attr("type", "submit"))
+		assert.Equal(t, `<button class="btn loading" type="submit" disabled>Get hat</button>`, n)
+	})
+
+	t.Run("does not change the original element", func(t *testing.T) {
+		b := button()
+		_ = g.WithAttrs(b, g.Attr("disabled"))
+		assert.Equal(t, `<button class="btn" type="button">Get hat</button>`, b)
+	})
+
+	t.Run("wraps other nodes in a div", func(t *testing.T) {
+		assert.Equal(t, `<div id="hat">hat</div>`, g.WithAttrs(g.Text("hat"), g.Attr("id", "hat")))
+	})
+}
+
+func TestKeyed(t *testing.T) {
+	t.Run("adds a data-key attribute to an element", func(t *testing.T) {
+		n := g.Keyed("hat1", g.El("li", g.Group([]g.Node{g.Attr("class", "hat")}), g.Text("Partyhat")))
+		assert.Equal(t, `<li class="hat" data-key="hat1">Partyhat</li>`, n)
+	})
+
+	t.Run("adds the attribute to elements in a list", func(t *testing.T) {
+		n := g.El("ul", g.Map([]string{"partyhat", "turtlehat"}, func(hat string) g.Node {
+			return g.Keyed(hat, g.El("li", g.Text(hat)))
+		}))
+		assert.Equal(t, `<ul><li data-key="partyhat">partyhat</li><li data-key="turtlehat">turtlehat</li></ul>`, n)
+	})
+
+	t.Run("does not change the original element", func(t *testing.T) {
+		e := g.El("li", g.Attr("class", "hat"))
+		_ = g.Keyed("hat1", e)
+		assert.Equal(t, `<li class="hat"></li>`, e)
+	})
+
+	t.Run("wraps other nodes in a div with the attribute", func(t *testing.T) {
+		n := g.Keyed("hat1", g.Group([]g.Node{g.El("span"), g.Text("hat")}))
+		assert.Equal(t, `<div data-key="hat1"><span></span>hat</div>`, n)
+	})
+}
+
+func TestMap(t *testing.T) {
+	t.Run("maps items to nodes as children of the parent element", func(t *testing.T) {
+		items := []string{"hat", "partyhat", "turtlehat"}
+		e := g.El("ul", g.Attr("class", "hats"), g.Map(items, func(item string) g.Node {
+			return g.El("li", g.Text(item))
+		}))
+		assert.Equal(t, `<ul class="hats"><li>hat</li><li>partyhat</li><li>turtlehat</li></ul>`, e)
+	})
+
+	t.Run("renders nothing for no items", func(t *testing.T) {
+		e := g.El("ul", g.Map([]string{}, func(item string) g.Node {
+			return g.El("li", g.Text(item))
+		}))
+		assert.Equal(t, `<ul></ul>`, e)
+	})
+}
+
+func TestMapIndexed(t *testing.T) {
+	t.Run("maps items and their index to nodes", func(t *testing.T) {
+		items := []string{"hat", "partyhat"}
+		e := g.El("ul", g.MapIndexed(items, func(i int, item string) g.Node {
+			return g.El("li", g.Textf("%v: %v", i, item))
+		}))
+		assert.Equal(t, `<ul><li>0: hat</li><li>1: partyhat</li></ul>`, e)
+	})
+}
+
+func BenchmarkElementRender(b *testing.B) {
+	var rows []g.Node
+	for i := 0; i < 100; i++ {
+		rows = append(rows, g.El("tr", g.Attr("class", "row"),
+			g.El("td", g.Textf("Hat %v", i)),
+			g.El("td", g.El("a", g.Attr("href", "/hats"), g.Text("Party & more"))),
+		))
+	}
+	var page g.Node = g.El("html", g.El("body", g.El("table", g.Group(rows))))
+
+	b.Run("to string", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_ = page.Render()
+		}
+	})
+
+	b.Run("to writer", func(b *testing.B) {
+		b.ReportAllocs()
+		var sb strings.Builder
+		for i := 0; i < b.N; i++ {
+			sb.Reset()
+			_ = g.Write(&sb, page)
+		}
+	})
+
+	b.Run("to reused buffer", func(b *testing.B) {
+		b.ReportAllocs()
+		var buf bytes.Buffer
+		for i := 0; i < b.N; i++ {
+			buf.Reset()
+			_ = g.RenderBuffer(&buf, page)
+		}
+	})
+}
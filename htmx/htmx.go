@@ -0,0 +1,106 @@
+// Package htmx provides attributes for htmx, see https://htmx.org
+package htmx
+
+import (
+	"strconv"
+
+	g "github.com/maragudk/gomponents"
+)
+
+// Get returns an attribute with name "hx-get" for a GET request to the given URL.
+func Get(url string) g.Node {
+	return g.Attr("hx-get", url)
+}
+
+// Post returns an attribute with name "hx-post" for a POST request to the given URL.
+func Post(url string) g.Node {
+	return g.Attr("hx-post", url)
+}
+
+// Put returns an attribute with name "hx-put" for a PUT request to the given URL.
+func Put(url string) g.Node {
+	return g.Attr("hx-put", url)
+}
+
+// Patch returns an attribute with name "hx-patch" for a PATCH request to the given URL.
+func Patch(url string) g.Node {
+	return g.Attr("hx-patch", url)
+}
+
+// Delete returns an attribute with name "hx-delete" for a DELETE request to the given URL.
+func Delete(url string) g.Node {
+	return g.Attr("hx-delete", url)
+}
+
+// Target returns an attribute with name "hx-target" for the element to swap the response into,
+// as a CSS selector or extended selector like "closest tr".
+func Target(selector string) g.Node {
+	return g.Attr("hx-target", selector)
+}
+
+// Swap returns an attribute with name "hx-swap" for how the response is swapped in, like "outerHTML" or "beforeend".
+func Swap(mode string) g.Node {
+	return g.Attr("hx-swap", mode)
+}
+
+// Trigger returns an attribute with name "hx-trigger" for the event that triggers the request,
+// like "click" or "keyup changed delay:500ms".
+func Trigger(event string) g.Node {
+	return g.Attr("hx-trigger", event)
+}
+
+// Select returns an attribute with name "hx-select" for the part of the response to swap in, as a CSS selector.
+func Select(selector string) g.Node {
+	return g.Attr("hx-select", selector)
+}
+
+// PushURL returns an attribute with name "hx-push-url" for whether to push the URL into the browser history,
+// "true", "false", or a URL.
+func PushURL(v string) g.Node {
+	return g.Attr("hx-push-url", v)
+}
+
+// Confirm returns an attribute with name "hx-confirm" for a message to confirm the request with.
+func Confirm(message string) g.Node {
+	return g.Attr("hx-confirm", message)
+}
+
+// Indicator returns an attribute with name "hx-indicator" for the element to show while the request is in flight,
+// as a CSS selector.
+func Indicator(selector string) g.Node {
+	return g.Attr("hx-indicator", selector)
+}
+
+// Include returns an attribute with name "hx-include" for additional elements to include values from in the request,
+// as a CSS selector.
+func Include(selector string) g.Node {
+	return g.Attr("hx-include", selector)
+}
+
+// Vals returns an attribute with name "hx-vals" for additional values to include in the request, as JSON.
+func Vals(v string) g.Node {
+	return g.Attr("hx-vals", v)
+}
+
+// Boost returns an attribute with name "hx-boost" and the value "true" or "false",
+// for whether links and forms in the element use htmx requests.
+func Boost(v bool) g.Node {
+	return g.Attr("hx-boost", strconv.FormatBool(v))
+}
+
+// On returns an attribute with name "hx-on:" followed by the event, with the given handler script.
+func On(event, handler string) g.Node {
+	return g.Attr("hx-on:"+event, handler)
+}
+
+// OOB returns n with an "id" attribute with the given id and an "hx-swap-oob" attribute with the value "true",
+// for out-of-band swaps of the element with that id. The attributes are added to n if it's an Element,
+// replacing any existing id. Other Nodes, like Groups, are wrapped in a "div" element with the attributes.
+func OOB(id string, n g.Node) g.Node {
+	attrs := []g.Node{g.Attr("id", id), g.Attr("hx-swap-oob", "true")}
+	e, ok := n.(g.Element)
+	if !ok {
+		return g.El("div", append(attrs, n)...)
+	}
+	return e.With(attrs...)
+}
@@ -0,0 +1,121 @@
+package htmx_test
+
+import (
+	"testing"
+
+	g "github.com/maragudk/gomponents"
+	"github.com/maragudk/gomponents/assert"
+	"github.com/maragudk/gomponents/htmx"
+)
+
+func TestGet(t *testing.T) {
+	t.Run("returns an hx-get attribute with the given value", func(t *testing.T) {
+		assert.Equal(t, ` hx-get="/hats"`, htmx.Get(`/hats`))
+	})
+}
+
+func TestPost(t *testing.T) {
+	t.Run("returns an hx-post attribute with the given value", func(t *testing.T) {
+		assert.Equal(t, ` hx-post="/hats"`, htmx.Post(`/hats`))
+	})
+}
+
+func TestPut(t *testing.T) {
+	t.Run("returns an hx-put attribute with the given value", func(t *testing.T) {
+		assert.Equal(t, ` hx-put="/hats"`, htmx.Put(`/hats`))
+	})
+}
+
+func TestPatch(t *testing.T) {
+	t.Run("returns an hx-patch attribute with the given value", func(t *testing.T) {
+		assert.Equal(t, ` hx-patch="/hats"`, htmx.Patch(`/hats`))
+	})
+}
+
+func TestDelete(t *testing.T) {
+	t.Run("returns an hx-delete attribute with the given value", func(t *testing.T) {
+		assert.Equal(t, ` hx-delete="/hats"`, htmx.Delete(`/hats`))
+	})
+}
+
+func TestTarget(t *testing.T) {
+	t.Run("returns an hx-target attribute with the given value", func(t *testing.T) {
+		assert.Equal(t, ` hx-target="#hats"`, htmx.Target(`#hats`))
+	})
+}
+
+func TestSwap(t *testing.T) {
+	t.Run("returns an hx-swap attribute with the given value", func(t *testing.T) {
+		assert.Equal(t, ` hx-swap="outerHTML"`, htmx.Swap(`outerHTML`))
+	})
+}
+
+func TestTrigger(t *testing.T) {
+	t.Run("returns an hx-trigger attribute with the given value", func(t *testing.T) {
+		assert.Equal(t, ` hx-trigger="click"`, htmx.Trigger(`click`))
+	})
+}
+
+func TestSelect(t *testing.T) {
+	t.Run("returns an hx-select attribute with the given value", func(t *testing.T) {
+		assert.Equal(t, ` hx-select="#hats"`, htmx.Select(`#hats`))
+	})
+}
+
+func TestPushURL(t *testing.T) {
+	t.Run("returns an hx-push-url attribute with the given value", func(t *testing.T) {
+		assert.Equal(t, ` hx-push-url="true"`, htmx.PushURL(`true`))
+	})
+}
+
+func TestConfirm(t *testing.T) {
+	t.Run("returns an hx-confirm attribute with the given value", func(t *testing.T) {
+		assert.Equal(t, ` hx-confirm="Really?"`, htmx.Confirm(`Really?`))
+	})
+}
+
+func TestIndicator(t *testing.T) {
+	t.Run("returns an hx-indicator attribute with the given value", func(t *testing.T) {
+		assert.Equal(t, ` hx-indicator="#hats"`, htmx.Indicator(`#hats`))
+	})
+}
+
+func TestInclude(t *testing.T) {
+	t.Run("returns an hx-include attribute with the given value", func(t *testing.T) {
+		assert.Equal(t, ` hx-include="#hats"`, htmx.Include(`#hats`))
+	})
+}
+
+func TestVals(t *testing.T) {
+	t.Run("returns an hx-vals attribute with the given value", func(t *testing.T) {
+		assert.Equal(t, ` hx-vals="{&#34;hat&#34;:&#34;party&#34;}"`, htmx.Vals(`{"hat":"party"}`))
+	})
+}
+
+func TestBoost(t *testing.T) {
+	t.Run("returns an hx-boost attribute with true or false", func(t *testing.T) {
+		assert.Equal(t, ` hx-boost="true"`, htmx.Boost(true))
+		assert.Equal(t, ` hx-boost="false"`, htmx.Boost(false))
+	})
+}
+
+func TestOn(t *testing.T) {
+	t.Run("returns an hx-on attribute for the event with the given handler", func(t *testing.T) {
+		assert.Equal(t, ` hx-on:htmx:after-request="alert(&#39;Hat!&#39;)"`, htmx.On("htmx:after-request", "alert('Hat!')"))
+	})
+}
+
+func TestOOB(t *testing.T) {
+	t.Run("adds the id and hx-swap-oob attributes to an element", func(t *testing.T) {
+		n := htmx.OOB("cart", g.El("div", g.Attr("class", "cart"), g.Text("3 hats")))
+		assert.Equal(t, `<div class="cart" id="cart" hx-swap-oob="true">3 hats</div>`, n)
+	})
+
+	t.Run("replaces an existing id", func(t *testing.T) {
+		assert.Equal(t, `<span id="count" hx-swap-oob="true">3</span>`, htmx.OOB("count", g.El("span", g.Attr("id", "badge"), g.Text("3"))))
+	})
+
+	t.Run("wraps other nodes in a div", func(t *testing.T) {
+		assert.Equal(t, `<div id="count" hx-swap-oob="true">3</div>`, htmx.OOB("count", g.Text("3")))
+	})
+}
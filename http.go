@@ -0,0 +1,245 @@
+package gomponents
+
+import (
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"hash/fnv"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// HandlerOption for Handler and HandlerFunc.
+type HandlerOption func(*handlerOptions)
+
+type handlerOptions struct {
+	fallback     Node
+	etag         bool
+	lastModified time.Time
+}
+
+// WithFallback sets a Node to render if the handler function returns a nil Node,
+// instead of responding with status 204 No Content.
+func WithFallback(n Node) HandlerOption {
+	return func(o *handlerOptions) {
+		o.fallback = n
+	}
+}
+
+// WithETag makes the handler render the whole Node before responding, and set an ETag header with a hash of it.
+// Requests with a matching If-None-Match header get status 304 Not Modified without a body.
+// Because of the buffering, the response isn't streamed, but a render error results in status 500
+// instead of an aborted response.
+func WithETag() HandlerOption {
+	return func(o *handlerOptions) {
+		o.etag = true
+	}
+}
+
+// WithLastModified makes the handler set a Last-Modified header with t, like the time the content last changed.
+// Requests with an If-Modified-Since header at or after t get status 304 Not Modified without a body.
+// Like with WithETag, the whole Node is rendered before responding. A zero t is ignored.
+func WithLastModified(t time.Time) HandlerOption {
+	return func(o *handlerOptions) {
+		o.lastModified = t
+	}
+}
+
+// Handler returns an http.Handler that renders the Node returned by fn,
+// with content type "text/html; charset=utf-8".
+// The Node is rendered with RenderContext and the request context, so ContextNodes get request-scoped values.
+// If fn returns an error, the handler responds with status 500 Internal Server Error instead.
+// If fn returns a nil Node, the handler responds with status 204 No Content, unless WithFallback is used.
+// If rendering fails after the response has started, the response is aborted with http.ErrAbortHandler,
+// so clients cannot mistake the truncated body for a complete one.
+func Handler(fn func(r *http.Request) (Node, error), opts ...HandlerOption) http.Handler {
+	var o handlerOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n, err := fn(r)
+		if err != nil {
+			http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+			return
+		}
+
+		if n == nil {
+			if o.fallback == nil {
+				w.WriteHeader(http.StatusNoContent)
+				return
+			}
+			n = o.fallback
+		}
+
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+
+		if o.etag || !o.lastModified.IsZero() {
+			var b bytes.Buffer
+			if err := RenderContext(r.Context(), &b, n); err != nil {
+				http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+				return
+			}
+			if o.etag {
+				h := fnv.New64a()
+				_, _ = h.Write(b.Bytes())
+				w.Header().Set("ETag", fmt.Sprintf(`"%x"`, h.Sum64()))
+			}
+			http.ServeContent(w, r, "", o.lastModified, bytes.NewReader(b.Bytes()))
+			return
+		}
+
+		if err := RenderContext(r.Context(), w, n); err != nil {
+			panic(http.ErrAbortHandler)
+		}
+	})
+}
+
+// HandlerFunc is like Handler, for functions that cannot return an error.
+func HandlerFunc(fn func(r *http.Request) Node, opts ...HandlerOption) http.Handler {
+	return Handler(func(r *http.Request) (Node, error) {
+		return fn(r), nil
+	}, opts...)
+}
+
+// Flush returns a Node that renders nothing, but flushes the written content to the client when rendering to an
+// http.ResponseWriter that implements http.Flusher, so the client gets it before the rest has rendered.
+// Put it after the head or above-the-fold content, before slow parts of the page.
+// It also flushes writers like bufio.Writer and gzip.Writer, that have a Flush method returning an error.
+func Flush() Node {
+	return flush{}
+}
+
+type flush struct{}
+
+func (f flush) Render() string {
+	return ""
+}
+
+// RenderTo satisfies Renderer.
+func (f flush) RenderTo(w io.Writer) error {
+	if sw, ok := w.(*statefulWriter); ok {
+		if sw.err != nil {
+			return sw.err
+		}
+		w = sw.w
+	}
+	if cw, ok := w.(*passthroughCountingWriter); ok {
+		w = cw.w
+	}
+	switch w := w.(type) {
+	case http.Flusher:
+		w.Flush()
+	case interface{ Flush() error }:
+		return w.Flush()
+	}
+	return nil
+}
+
+func (f flush) Place() Placement {
+	return Outside
+}
+
+// WriteSSE renders n to w as a server-sent event with the given event name, for live updates with EventSource.
+// Each line of the rendered output is written as a "data:" line, so multi-line output like from RenderIndented
+// arrives as-is, and the event ends with a blank line. If event is empty, the "event:" line is left out.
+// w is flushed afterwards if it's an http.Flusher.
+// See https://html.spec.whatwg.org/multipage/server-sent-events.html
+func WriteSSE(w io.Writer, event string, n Node) error {
+	if strings.ContainsAny(event, "\r\n") {
+		return fmt.Errorf("invalid event name %q", event)
+	}
+
+	var b bytes.Buffer
+	if err := RenderBuffer(&b, n); err != nil {
+		return err
+	}
+
+	var out strings.Builder
+	if event != "" {
+		out.WriteString("event: " + event + "\n")
+	}
+	data := strings.NewReplacer("\r\n", "\n", "\r", "\n").Replace(b.String())
+	for _, line := range strings.Split(data, "\n") {
+		out.WriteString("data: " + line + "\n")
+	}
+	out.WriteString("\n")
+	if _, err := io.WriteString(w, out.String()); err != nil {
+		return err
+	}
+
+	if f, ok := w.(http.Flusher); ok {
+		f.Flush()
+	}
+	return nil
+}
+
+// WriteCompressed renders n to w with the request context (see RenderContext), gzip-compressed
+// if the client accepts it according to the Accept-Encoding header of r. The content is compressed while rendering.
+// It sets the Content-Encoding and Vary headers accordingly, but not Content-Type.
+// Flush works with the compressed content as well.
+func WriteCompressed(w http.ResponseWriter, r *http.Request, n Node) error {
+	w.Header().Add("Vary", "Accept-Encoding")
+	if !acceptsGzip(r.Header.Get("Accept-Encoding")) {
+		return RenderContext(r.Context(), w, n)
+	}
+
+	w.Header().Set("Content-Encoding", "gzip")
+	w.Header().Del("Content-Length")
+	gw := gzipWriterPool.Get().(*gzip.Writer)
+	gw.Reset(w)
+	defer gzipWriterPool.Put(gw)
+	if err := RenderContext(r.Context(), &gzipFlusher{Writer: gw, w: w}, n); err != nil {
+		return err
+	}
+	return gw.Close()
+}
+
+// gzipFlusher flushes both the gzip.Writer and the http.ResponseWriter, for Flush.
+type gzipFlusher struct {
+	*gzip.Writer
+	w http.ResponseWriter
+}
+
+func (f *gzipFlusher) Flush() {
+	if err := f.Writer.Flush(); err != nil {
+		return
+	}
+	if fl, ok := f.w.(http.Flusher); ok {
+		fl.Flush()
+	}
+}
+
+var gzipWriterPool = sync.Pool{
+	New: func() interface{} {
+		return gzip.NewWriter(io.Discard)
+	},
+}
+
+// acceptsGzip returns whether the Accept-Encoding header value h allows gzip, directly or through "*".
+func acceptsGzip(h string) bool {
+	accepts := false
+	for _, part := range strings.Split(h, ",") {
+		coding, params, _ := strings.Cut(strings.TrimSpace(part), ";")
+		coding = strings.ToLower(strings.TrimSpace(coding))
+		if coding != "gzip" && coding != "*" {
+			continue
+		}
+		q := 1.0
+		if name, value, ok := strings.Cut(strings.TrimSpace(params), "="); ok && strings.TrimSpace(name) == "q" {
+			if v, err := strconv.ParseFloat(strings.TrimSpace(value), 64); err == nil {
+				q = v
+			}
+		}
+		if coding == "gzip" {
+			return q > 0
+		}
+		accepts = q > 0
+	}
+	return accepts
+}
@@ -0,0 +1,381 @@
+package gomponents_test
+
+import (
+	"compress/gzip"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	g "github.com/maragudk/gomponents"
+	"github.com/maragudk/gomponents/assert"
+)
+
+func TestHandler(t *testing.T) {
+	t.Run("renders the node as html", func(t *testing.T) {
+		h := g.Handler(func(r *http.Request) (g.Node, error) {
+			return g.El("div", g.Text(r.URL.Path)), nil
+		})
+		code, header, body := get(t, h)
+		if code != http.StatusOK {
+			t.Fatalf("expected status 200, got %v", code)
+		}
+		if header.Get("Content-Type") != "text/html; charset=utf-8" {
+			t.Fatalf("unexpected content type %v", header.Get("Content-Type"))
+		}
+		if body != "<div>/hat</div>" {
+			t.Fatalf("unexpected body %v", body)
+		}
+	})
+
+	t.Run("responds with 500 on error", func(t *testing.T) {
+		h := g.Handler(func(r *http.Request) (g.Node, error) {
+			return g.El("div"), errors.New("no hats")
+		})
+		code, _, _ := get(t, h)
+		if code != http.StatusInternalServerError {
+			t.Fatalf("expected status 500, got %v", code)
+		}
+	})
+
+	t.Run("responds with 204 on nil node", func(t *testing.T) {
+		h := g.Handler(func(r *http.Request) (g.Node, error) {
+			return nil, nil
+		})
+		code, _, body := get(t, h)
+		if code != http.StatusNoContent || body != "" {
+			t.Fatalf("expected status 204 and no body, got %v and %v", code, body)
+		}
+	})
+
+	t.Run("renders the fallback on nil node if given", func(t *testing.T) {
+		h := g.Handler(func(r *http.Request) (g.Node, error) {
+			return nil, nil
+		}, g.WithFallback(g.El("p", g.Text("No hats."))))
+		code, _, body := get(t, h)
+		if code != http.StatusOK || body != "<p>No hats.</p>" {
+			t.Fatalf("expected status 200 and the fallback, got %v and %v", code, body)
+		}
+	})
+
+	t.Run("renders with the request context", func(t *testing.T) {
+		h := g.Handler(func(r *http.Request) (g.Node, error) {
+			return g.El("div", hatFromContext{}), nil
+		})
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest(http.MethodGet, "/", nil)
+		h.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), hatKey{}, "partyhat")))
+		if w.Body.String() != "<div>partyhat</div>" {
+			t.Fatalf("unexpected body %v", w.Body.String())
+		}
+	})
+
+	t.Run("aborts the response on render error", func(t *testing.T) {
+		h := g.Handler(func(r *http.Request) (g.Node, error) {
+			return g.El("div"), nil
+		})
+		defer func() {
+			if err := recover(); err != http.ErrAbortHandler {
+				t.Fatalf("expected http.ErrAbortHandler panic, got %v", err)
+			}
+		}()
+		h.ServeHTTP(&erroringResponseWriter{ResponseWriter: httptest.NewRecorder()}, httptest.NewRequest(http.MethodGet, "/", nil))
+	})
+}
+
+func TestWithETag(t *testing.T) {
+	h := g.Handler(func(r *http.Request) (g.Node, error) {
+		return g.El("div", g.Text(r.URL.Path)), nil
+	}, g.WithETag())
+
+	t.Run("sets an etag header", func(t *testing.T) {
+		code, header, body := get(t, h)
+		if code != http.StatusOK || body != "<div>/hat</div>" {
+			t.Fatalf("expected status 200 and a div, got %v and %v", code, body)
+		}
+		if etag := header.Get("ETag"); len(etag) < 3 || etag[0] != '"' {
+			t.Fatalf("unexpected etag %v", etag)
+		}
+		if header.Get("Content-Type") != "text/html; charset=utf-8" {
+			t.Fatalf("unexpected content type %v", header.Get("Content-Type"))
+		}
+	})
+
+	t.Run("responds with 304 if the etag matches", func(t *testing.T) {
+		_, header, _ := get(t, h)
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest(http.MethodGet, "/hat", nil)
+		r.Header.Set("If-None-Match", header.Get("ETag"))
+		h.ServeHTTP(w, r)
+		if w.Code != http.StatusNotModified || w.Body.String() != "" {
+			t.Fatalf("expected status 304 and no body, got %v and %v", w.Code, w.Body.String())
+		}
+	})
+
+	t.Run("responds with the body if the etag does not match", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest(http.MethodGet, "/hat", nil)
+		r.Header.Set("If-None-Match", `"partyhat"`)
+		h.ServeHTTP(w, r)
+		if w.Code != http.StatusOK || w.Body.String() != "<div>/hat</div>" {
+			t.Fatalf("expected status 200 and a div, got %v and %v", w.Code, w.Body.String())
+		}
+	})
+
+	t.Run("responds with 500 on render error", func(t *testing.T) {
+		h := g.Handler(func(r *http.Request) (g.Node, error) {
+			return erroringNode{}, nil
+		}, g.WithETag())
+		code, _, _ := get(t, h)
+		if code != http.StatusInternalServerError {
+			t.Fatalf("expected status 500, got %v", code)
+		}
+	})
+}
+
+func TestWithLastModified(t *testing.T) {
+	modified := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+	h := g.Handler(func(r *http.Request) (g.Node, error) {
+		return g.El("div", g.Text(r.URL.Path)), nil
+	}, g.WithLastModified(modified))
+
+	t.Run("sets a last-modified header", func(t *testing.T) {
+		code, header, body := get(t, h)
+		if code != http.StatusOK || body != "<div>/hat</div>" {
+			t.Fatalf("expected status 200 and a div, got %v and %v", code, body)
+		}
+		if header.Get("Last-Modified") != "Fri, 01 Mar 2024 12:00:00 GMT" {
+			t.Fatalf("unexpected last-modified %v", header.Get("Last-Modified"))
+		}
+		if header.Get("ETag") != "" {
+			t.Fatalf("unexpected etag %v", header.Get("ETag"))
+		}
+	})
+
+	t.Run("responds with 304 if not modified since", func(t *testing.T) {
+		for ifModifiedSince, expected := range map[time.Time]int{
+			modified:                 http.StatusNotModified,
+			modified.Add(time.Hour):  http.StatusNotModified,
+			modified.Add(-time.Hour): http.StatusOK,
+		} {
+			w := httptest.NewRecorder()
+			r := httptest.NewRequest(http.MethodGet, "/hat", nil)
+			r.Header.Set("If-Modified-Since", ifModifiedSince.Format(http.TimeFormat))
+			h.ServeHTTP(w, r)
+			if w.Code != expected {
+				t.Fatalf("expected status %v for %v, got %v", expected, ifModifiedSince, w.Code)
+			}
+		}
+	})
+
+	t.Run("responds with 304 for both validators", func(t *testing.T) {
+		h := g.Handler(func(r *http.Request) (g.Node, error) {
+			return g.El("div"), nil
+		}, g.WithETag(), g.WithLastModified(modified))
+		_, header, _ := get(t, h)
+
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest(http.MethodGet, "/hat", nil)
+		r.Header.Set("If-None-Match", header.Get("ETag"))
+		h.ServeHTTP(w, r)
+		if w.Code != http.StatusNotModified {
+			t.Fatalf("expected status 304 for the etag, got %v", w.Code)
+		}
+
+		w = httptest.NewRecorder()
+		r = httptest.NewRequest(http.MethodGet, "/hat", nil)
+		r.Header.Set("If-Modified-Since", modified.Format(http.TimeFormat))
+		h.ServeHTTP(w, r)
+		if w.Code != http.StatusNotModified || w.Header().Get("ETag") == "" {
+			t.Fatalf("expected status 304 and an etag for the modification time, got %v", w.Code)
+		}
+	})
+}
+
+// erroringNode always fails to render.
+type erroringNode struct{}
+
+func (n erroringNode) Render() string {
+	return ""
+}
+
+func (n erroringNode) RenderTo(w io.Writer) error {
+	return errors.New("no hats")
+}
+
+func TestHandlerFunc(t *testing.T) {
+	t.Run("renders the node as html", func(t *testing.T) {
+		h := g.HandlerFunc(func(r *http.Request) g.Node {
+			return g.El("div", g.Text(r.URL.Path))
+		})
+		code, _, body := get(t, h)
+		if code != http.StatusOK || body != "<div>/hat</div>" {
+			t.Fatalf("expected status 200 and a div, got %v and %v", code, body)
+		}
+	})
+}
+
+func get(t *testing.T, h http.Handler) (int, http.Header, string) {
+	t.Helper()
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/hat", nil))
+	return w.Code, w.Header(), w.Body.String()
+}
+
+type erroringResponseWriter struct {
+	http.ResponseWriter
+}
+
+func (w *erroringResponseWriter) Write(p []byte) (int, error) {
+	return 0, errWrite
+}
+
+func TestWriteCompressed(t *testing.T) {
+	n := g.El("div", g.Text(strings.Repeat("hat ", 100)))
+
+	t.Run("compresses with gzip if accepted", func(t *testing.T) {
+		for _, accept := range []string{"gzip", "deflate, gzip;q=0.5", "*", "GZIP, br"} {
+			w := httptest.NewRecorder()
+			r := httptest.NewRequest(http.MethodGet, "/", nil)
+			r.Header.Set("Accept-Encoding", accept)
+			if err := g.WriteCompressed(w, r, n); err != nil {
+				t.Fatal(err)
+			}
+			if w.Header().Get("Content-Encoding") != "gzip" || w.Header().Get("Vary") != "Accept-Encoding" {
+				t.Fatalf("unexpected headers for %v: %v", accept, w.Header())
+			}
+			gr, err := gzip.NewReader(w.Body)
+			if err != nil {
+				t.Fatal(err)
+			}
+			body, err := io.ReadAll(gr)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(body) != n.Render() {
+				t.Fatalf("unexpected body %v", string(body))
+			}
+		}
+	})
+
+	t.Run("writes uncompressed if gzip is not accepted", func(t *testing.T) {
+		for _, accept := range []string{"", "br", "gzip;q=0", "*, gzip;q=0", "*;q=0"} {
+			w := httptest.NewRecorder()
+			r := httptest.NewRequest(http.MethodGet, "/", nil)
+			r.Header.Set("Accept-Encoding", accept)
+			if err := g.WriteCompressed(w, r, n); err != nil {
+				t.Fatal(err)
+			}
+			if w.Header().Get("Content-Encoding") != "" || w.Body.String() != n.Render() {
+				t.Fatalf("unexpected response for %v: %v", accept, w.Header())
+			}
+		}
+	})
+
+	t.Run("errors on write error", func(t *testing.T) {
+		r := httptest.NewRequest(http.MethodGet, "/", nil)
+		r.Header.Set("Accept-Encoding", "gzip")
+		if err := g.WriteCompressed(&erroringResponseWriter{ResponseWriter: httptest.NewRecorder()}, r, n); err == nil {
+			t.FailNow()
+		}
+	})
+}
+
+func TestFlush(t *testing.T) {
+	t.Run("flushes the response writer", func(t *testing.T) {
+		w := &flushRecorder{ResponseRecorder: httptest.NewRecorder()}
+		n := g.El("html", g.El("head"), g.Flush(), g.El("body", g.NodeFunc(func() string {
+			if w.flushedBody != "<html><head></head>" {
+				t.Errorf("unexpected flushed body %v", w.flushedBody)
+			}
+			return "hat"
+		})))
+		if err := g.Write(w, n); err != nil {
+			t.Fatal(err)
+		}
+		if w.Body.String() != "<html><head></head><body>hat</body></html>" {
+			t.Fatalf("unexpected body %v", w.Body.String())
+		}
+	})
+
+	t.Run("flushes compressed content", func(t *testing.T) {
+		w := &flushRecorder{ResponseRecorder: httptest.NewRecorder()}
+		r := httptest.NewRequest(http.MethodGet, "/", nil)
+		r.Header.Set("Accept-Encoding", "gzip")
+		if err := g.WriteCompressed(w, r, g.Group([]g.Node{g.El("head"), g.Flush()})); err != nil {
+			t.Fatal(err)
+		}
+		if w.flushes != 1 || w.flushedBody == "" {
+			t.Fatalf("expected a flush with content, got %v flushes", w.flushes)
+		}
+	})
+
+	t.Run("flushes when written with WriteTo", func(t *testing.T) {
+		w := &flushRecorder{ResponseRecorder: httptest.NewRecorder()}
+		if _, err := g.El("div", g.Flush()).WriteTo(w); err != nil {
+			t.Fatal(err)
+		}
+		if w.flushes != 1 {
+			t.Fatalf("expected a flush, got %v", w.flushes)
+		}
+	})
+
+	t.Run("renders nothing", func(t *testing.T) {
+		assert.Equal(t, "<div></div>", g.El("div", g.Flush()))
+	})
+}
+
+func TestWriteSSE(t *testing.T) {
+	t.Run("writes the node as an event and flushes", func(t *testing.T) {
+		w := &flushRecorder{ResponseRecorder: httptest.NewRecorder()}
+		if err := g.WriteSSE(w, "hat", g.El("div", g.Text("Party hat"))); err != nil {
+			t.Fatal(err)
+		}
+		if w.Body.String() != "event: hat\ndata: <div>Party hat</div>\n\n" {
+			t.Fatalf("unexpected body %q", w.Body.String())
+		}
+		if w.flushes != 1 {
+			t.Fatalf("expected a flush, got %v", w.flushes)
+		}
+	})
+
+	t.Run("prefixes each line of multi-line output", func(t *testing.T) {
+		var b strings.Builder
+		if err := g.WriteSSE(&b, "", g.Raw("<ul>\r\n<li>hat</li>\n</ul>\n")); err != nil {
+			t.Fatal(err)
+		}
+		if b.String() != "data: <ul>\ndata: <li>hat</li>\ndata: </ul>\ndata: \n\n" {
+			t.Fatalf("unexpected body %q", b.String())
+		}
+	})
+
+	t.Run("errors on an event name with a newline", func(t *testing.T) {
+		var b strings.Builder
+		if err := g.WriteSSE(&b, "hat\ndata: evil", g.Text("hat")); err == nil || b.Len() > 0 {
+			t.FailNow()
+		}
+	})
+
+	t.Run("errors on render error", func(t *testing.T) {
+		var b strings.Builder
+		if err := g.WriteSSE(&b, "hat", erroringNode{}); err == nil || b.Len() > 0 {
+			t.FailNow()
+		}
+	})
+}
+
+type flushRecorder struct {
+	*httptest.ResponseRecorder
+	flushes     int
+	flushedBody string
+}
+
+func (r *flushRecorder) Flush() {
+	r.flushes++
+	r.flushedBody = r.Body.String()
+	r.ResponseRecorder.Flush()
+}
@@ -0,0 +1,100 @@
+package gomponents
+
+import (
+	"context"
+	"io"
+)
+
+// Layout returns a Node that renders layout, with each Slot in it filled with the Node for its name in slots.
+// The Nodes in slots are rendered with the context from outside the Layout, so they can be Layouts themselves,
+// and a Slot in them refers to the slots of an outer Layout, if any.
+//
+//	func Page(title string, body g.Node) g.Node {
+//		return g.Layout(BaseLayout(), map[string]g.Node{
+//			"title":   g.Text(title),
+//			"content": body,
+//		})
+//	}
+//
+//	func BaseLayout() g.Node {
+//		return el.HTML(el.Head(g.El("title", g.Slot("title", g.Text("Hats")))), el.Body(g.Slot("content", nil)))
+//	}
+func Layout(layout Node, slots map[string]Node) Node {
+	return layoutNode{layout: layout, slots: slots}
+}
+
+// Slot returns a Node that renders the Node with the given name from the slots of the surrounding Layout.
+// If there is none, the default Node is rendered instead, which may be nil to render nothing.
+func Slot(name string, defaultNode Node) Node {
+	return slot{name: name, defaultNode: defaultNode}
+}
+
+type slotsKey struct{}
+
+// slots of a Layout in the render context, together with the slots of an outer Layout, if any.
+type slots struct {
+	nodes map[string]Node
+	outer interface{}
+}
+
+type layoutNode struct {
+	layout Node
+	slots  map[string]Node
+}
+
+func (l layoutNode) Render() string {
+	return renderString(l)
+}
+
+// RenderTo satisfies Renderer.
+func (l layoutNode) RenderTo(w io.Writer) error {
+	return l.RenderContext(writerContext(w), w)
+}
+
+// RenderContext satisfies ContextNode.
+func (l layoutNode) RenderContext(ctx context.Context, w io.Writer) error {
+	return RenderContext(context.WithValue(ctx, slotsKey{}, slots{nodes: l.slots, outer: ctx.Value(slotsKey{})}), w, l.layout)
+}
+
+func (l layoutNode) Place() Placement {
+	return Outside
+}
+
+// String satisfies fmt.Stringer.
+func (l layoutNode) String() string {
+	return l.Render()
+}
+
+type slot struct {
+	name        string
+	defaultNode Node
+}
+
+func (s slot) Render() string {
+	return renderString(s)
+}
+
+// RenderTo satisfies Renderer.
+func (s slot) RenderTo(w io.Writer) error {
+	return s.RenderContext(writerContext(w), w)
+}
+
+// RenderContext satisfies ContextNode.
+func (s slot) RenderContext(ctx context.Context, w io.Writer) error {
+	if sl, ok := ctx.Value(slotsKey{}).(slots); ok {
+		if n, ok := sl.nodes[s.name]; ok {
+			// Render with the context of the layout, like for HoistAssets, but with the slots outside the Layout
+			return RenderContext(context.WithValue(ctx, slotsKey{}, sl.outer), w, n)
+		}
+	}
+	return RenderContext(ctx, w, s.defaultNode)
+}
+
+func (s slot) Place() Placement {
+	return Outside
+}
+
+// String satisfies fmt.Stringer.
+func (s slot) String() string {
+	return s.Render()
+}
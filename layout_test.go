@@ -0,0 +1,36 @@
+package gomponents_test
+
+import (
+	"testing"
+
+	g "github.com/maragudk/gomponents"
+	"github.com/maragudk/gomponents/assert"
+)
+
+func TestLayout(t *testing.T) {
+	base := g.El("body", g.El("header", g.Slot("header", g.Text("Hats"))), g.El("main", g.Slot("content", nil)))
+
+	t.Run("fills slots in the layout", func(t *testing.T) {
+		n := g.Layout(base, map[string]g.Node{"header": g.Text("Party hats"), "content": g.El("p", g.Text("Hat."))})
+		assert.Equal(t, "<body><header>Party hats</header><main><p>Hat.</p></main></body>", n)
+	})
+
+	t.Run("renders the defaults of slots that aren't filled", func(t *testing.T) {
+		assert.Equal(t, "<body><header>Hats</header><main></main></body>", g.Layout(base, nil))
+	})
+
+	t.Run("renders the defaults outside a layout", func(t *testing.T) {
+		assert.Equal(t, "<body><header>Hats</header><main></main></body>", base)
+	})
+
+	t.Run("fills slots of nested layouts", func(t *testing.T) {
+		twoColumns := g.El("div", g.El("aside", g.Slot("sidebar", nil)), g.Slot("content", nil))
+		page := g.Layout(base, map[string]g.Node{
+			"content": g.Layout(twoColumns, map[string]g.Node{
+				"sidebar": g.Text("Menu"),
+				"content": g.El("p", g.Slot("header", g.Text("No header"))),
+			}),
+		})
+		assert.Equal(t, "<body><header>Hats</header><main><div><aside>Menu</aside><p>No header</p></div></main></body>", page)
+	})
+}
@@ -0,0 +1,22 @@
+// Package markdown provides rendering of Markdown to gomponents Nodes, using goldmark.
+package markdown
+
+import (
+	"bytes"
+
+	"github.com/yuin/goldmark"
+
+	g "github.com/maragudk/gomponents"
+)
+
+// Parse the CommonMark Markdown in source into a Node tree of Elements and Text, like headings, paragraphs,
+// lists, code blocks, and links, so it can be inspected, wrapped, and transformed like any other Node.
+// Raw HTML in source is not included in the result.
+// See gomponents.Parse for the structure of the result.
+func Parse(source string) (g.Node, error) {
+	var b bytes.Buffer
+	if err := goldmark.Convert([]byte(source), &b); err != nil {
+		return nil, err
+	}
+	return g.Parse(&b)
+}
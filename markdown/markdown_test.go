@@ -0,0 +1,47 @@
+package markdown_test
+
+import (
+	"testing"
+
+	g "github.com/maragudk/gomponents"
+	"github.com/maragudk/gomponents/assert"
+	"github.com/maragudk/gomponents/markdown"
+)
+
+func TestParse(t *testing.T) {
+	t.Run("parses markdown into nodes", func(t *testing.T) {
+		n, err := markdown.Parse("# Hats\n\nA *party* hat, see [hats](/hats).\n\n- Partyhat\n- Turtlehat\n")
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, "<h1>Hats</h1>\n<p>A <em>party</em> hat, see <a href=\"/hats\">hats</a>.</p>\n"+
+			"<ul>\n<li>Partyhat</li>\n<li>Turtlehat</li>\n</ul>\n", n)
+
+		var headings int
+		g.Walk(n, func(n g.Node) bool {
+			if e, ok := n.(g.Element); ok && e.Name() == "h1" {
+				headings++
+			}
+			return true
+		})
+		if headings != 1 {
+			t.Fatalf("expected one heading element, got %v", headings)
+		}
+	})
+
+	t.Run("does not escape code block content twice", func(t *testing.T) {
+		n, err := markdown.Parse("```\nif hats < 2 && partyhat {}\n```\n")
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, "<pre><code>if hats &lt; 2 &amp;&amp; partyhat {}\n</code></pre>\n", n)
+	})
+
+	t.Run("omits raw html", func(t *testing.T) {
+		n, err := markdown.Parse("<script>alert('hat')</script>\n")
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, "<!-- raw HTML omitted -->\n", n)
+	})
+}
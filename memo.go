@@ -0,0 +1,272 @@
+package gomponents
+
+import (
+	"container/list"
+	"context"
+	"io"
+	"strings"
+	"sync"
+)
+
+// Memo returns a Node that renders n once, the first time it's rendered, and then renders the cached result.
+// Use it for static parts of a page, like a site header or footer, to skip rendering them on every request.
+// n must render the same every time: it must not depend on changing data or the render context,
+// because the result of the first successful render, with the context of that render, is always used.
+// Render errors, like from a cancelled context, are not cached, so n is rendered again the next time.
+// Assets required with RequireAsset or placed with InHead, and errors from ErrNode in n, are cached as well,
+// and collected again every time, so they are hoisted with HoistAssets on every render.
+// Memo is safe for concurrent use.
+func Memo(n Node) Node {
+	return &memo{n: n}
+}
+
+type memo struct {
+	n       Node
+	lock    sync.Mutex
+	results [2]*memoResult
+}
+
+func (m *memo) Render() string {
+	return renderString(m)
+}
+
+// RenderTo satisfies Renderer.
+func (m *memo) RenderTo(w io.Writer) error {
+	return m.RenderContext(writerContext(w), w)
+}
+
+// RenderContext satisfies ContextNode.
+func (m *memo) RenderContext(ctx context.Context, w io.Writer) error {
+	mode := memoMode(ctx)
+	m.lock.Lock()
+	r := m.results[mode]
+	if r == nil {
+		var err error
+		if r, err = renderMemo(ctx, m.n); err != nil {
+			m.lock.Unlock()
+			return err
+		}
+		m.results[mode] = r
+	}
+	m.lock.Unlock()
+	return r.replay(ctx, w)
+}
+
+// Place satisfies Placer, with the Placement of the memoized Node.
+func (m *memo) Place() Placement {
+	return placement(m.n)
+}
+
+// String satisfies fmt.Stringer.
+func (m *memo) String() string {
+	return m.Render()
+}
+
+// MemoKey returns a Node that renders the Node returned by build, and caches the result by key.
+// As long as key is in the cache, build isn't called, and the cached result is rendered instead.
+// Put a version in the key, like an ID together with an update timestamp, so the content
+// is rendered again when it changes. Like with Memo, the content must not depend on the render context.
+// Render errors are not cached.
+//
+// The cache is shared by all MemoKey Nodes in the program, so keys must be unique across all components
+// that use it, including in other packages: MemoKey("42", card) and MemoKey("42", row) render the same cached
+// result, whichever was rendered first. Prefix keys with the component, like "card-42", or use a MemoCache
+// of your own with NewMemoCache.
+// The shared cache holds the most recently used results up to a size set with SetMemoKeySize.
+// Clear it with ClearMemoKey.
+// MemoKey is safe for concurrent use.
+func MemoKey(key string, build func() Node) Node {
+	return memoKeyCache.Key(key, build)
+}
+
+// SetMemoKeySize sets the maximum number of results in the MemoKey cache, which is 1000 by default.
+// If the cache holds more than that, the least recently used results are removed.
+// A size less than one turns the cache off.
+func SetMemoKeySize(size int) {
+	memoKeyCache.SetSize(size)
+}
+
+// ClearMemoKey removes all results from the MemoKey cache.
+func ClearMemoKey() {
+	memoKeyCache.Clear()
+}
+
+// MemoCache caches rendered results by key like MemoKey, but only for the Nodes created with its Key method,
+// so its keys don't collide with keys used elsewhere. Create it with NewMemoCache.
+// It's safe for concurrent use.
+type MemoCache struct {
+	lru *lru
+}
+
+// NewMemoCache returns a MemoCache that holds the most recently used results up to the given size.
+// A size less than one turns the cache off.
+func NewMemoCache(size int) *MemoCache {
+	return &MemoCache{lru: newLRU(size)}
+}
+
+// Key returns a Node like MemoKey, which caches the result in c.
+func (c *MemoCache) Key(key string, build func() Node) Node {
+	return memoKey{cache: c.lru, key: key, build: build}
+}
+
+// SetSize sets the maximum number of results in the cache, like SetMemoKeySize.
+func (c *MemoCache) SetSize(size int) {
+	c.lru.setSize(size)
+}
+
+// Clear removes all results from the cache.
+func (c *MemoCache) Clear() {
+	c.lru.clear()
+}
+
+type memoKey struct {
+	cache *lru
+	key   string
+	build func() Node
+}
+
+func (m memoKey) Render() string {
+	return renderString(m)
+}
+
+// RenderTo satisfies Renderer.
+func (m memoKey) RenderTo(w io.Writer) error {
+	return m.RenderContext(writerContext(w), w)
+}
+
+// RenderContext satisfies ContextNode.
+func (m memoKey) RenderContext(ctx context.Context, w io.Writer) error {
+	// Results with assets for HoistAssets are cached separately from the ones without
+	key := string(rune('0'+memoMode(ctx))) + m.key
+	r, ok := m.cache.get(key)
+	if !ok {
+		var err error
+		if r, err = renderMemo(ctx, m.build()); err != nil {
+			return err
+		}
+		m.cache.add(key, r)
+	}
+	return r.replay(ctx, w)
+}
+
+var memoKeyCache = NewMemoCache(1000)
+
+// memoResult of rendering a Node for Memo and MemoKey, with the assets and errors collected while rendering,
+// so they can be collected again each time the result is rendered.
+type memoResult struct {
+	s      string
+	assets []string
+	errs   []error
+}
+
+// memoMode returns 1 if rendering for HoistAssets, and 0 otherwise.
+// Nodes placed in the Head render differently then, so the results are cached separately.
+func memoMode(ctx context.Context) int {
+	if _, ok := ctx.Value(assetsKey{}).(*assetCollector); ok {
+		return 1
+	}
+	return 0
+}
+
+// renderMemo renders n, recording the assets for HoistAssets and the errors from ErrNode.
+func renderMemo(ctx context.Context, n Node) (*memoResult, error) {
+	var assets *assetCollector
+	if memoMode(ctx) == 1 {
+		assets = &assetCollector{seen: map[string]struct{}{}}
+		ctx = context.WithValue(ctx, assetsKey{}, assets)
+	}
+	errs := &errorCollector{}
+	ctx = context.WithValue(ctx, errorsKey{}, errs)
+
+	var b strings.Builder
+	if err := RenderContext(ctx, &b, n); err != nil {
+		return nil, err
+	}
+	r := &memoResult{s: b.String(), errs: errs.errs}
+	if assets != nil {
+		for _, a := range assets.assets {
+			r.assets = append(r.assets, a.Render())
+		}
+	}
+	return r, nil
+}
+
+// replay the recorded assets and errors into the collectors in ctx, and write the result to w.
+// Without an errors collector, the first recorded error is returned, like ErrNode does.
+func (r *memoResult) replay(ctx context.Context, w io.Writer) error {
+	if c, ok := ctx.Value(assetsKey{}).(*assetCollector); ok && c != nil {
+		for _, a := range r.assets {
+			c.addRendered(a)
+		}
+	}
+	if c, ok := ctx.Value(errorsKey{}).(*errorCollector); ok {
+		c.errs = append(c.errs, r.errs...)
+	} else if len(r.errs) > 0 {
+		return r.errs[0]
+	}
+	_, err := io.WriteString(w, r.s)
+	return err
+}
+
+// lru is a concurrency-safe cache of memo results, which removes the least recently used entries above its size.
+type lru struct {
+	lock    sync.Mutex
+	size    int
+	list    *list.List
+	entries map[string]*list.Element
+}
+
+type lruEntry struct {
+	key   string
+	value *memoResult
+}
+
+func newLRU(size int) *lru {
+	return &lru{size: size, list: list.New(), entries: map[string]*list.Element{}}
+}
+
+func (c *lru) get(key string) (*memoResult, bool) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	e, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+	c.list.MoveToFront(e)
+	return e.Value.(*lruEntry).value, true
+}
+
+func (c *lru) add(key string, value *memoResult) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if e, ok := c.entries[key]; ok {
+		e.Value.(*lruEntry).value = value
+		c.list.MoveToFront(e)
+		return
+	}
+	c.entries[key] = c.list.PushFront(&lruEntry{key: key, value: value})
+	c.evict()
+}
+
+func (c *lru) setSize(size int) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.size = size
+	c.evict()
+}
+
+func (c *lru) clear() {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.list.Init()
+	c.entries = map[string]*list.Element{}
+}
+
+// evict the least recently used entries above the size. The lock must be held.
+func (c *lru) evict() {
+	for c.list.Len() > 0 && c.list.Len() > c.size {
+		e := c.list.Back()
+		c.list.Remove(e)
+		delete(c.entries, e.Value.(*lruEntry).key)
+	}
+}
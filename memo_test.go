@@ -0,0 +1,188 @@
+package gomponents_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	g "github.com/maragudk/gomponents"
+	"github.com/maragudk/gomponents/assert"
+)
+
+func TestMemo(t *testing.T) {
+	t.Run("renders the node only once", func(t *testing.T) {
+		var renders int
+		n := g.Memo(g.El("header", g.NodeFunc(func() string {
+			renders++
+			return "Hats"
+		})))
+		assert.Equal(t, "<header>Hats</header>", n)
+		assert.Equal(t, "<div><header>Hats</header></div>", g.El("div", n))
+		if renders != 1 {
+			t.Fatalf("expected one render, got %v", renders)
+		}
+	})
+
+	t.Run("has the placement of the node", func(t *testing.T) {
+		assert.Equal(t, `<div class="hat"></div>`, g.El("div", g.Memo(g.Attr("class", "hat"))))
+	})
+
+	t.Run("is safe for concurrent use", func(t *testing.T) {
+		var renders int32
+		n := g.Memo(g.NodeFunc(func() string {
+			atomic.AddInt32(&renders, 1)
+			return "hat"
+		}))
+		var wg sync.WaitGroup
+		for i := 0; i < 10; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				if n.Render() != "hat" {
+					t.Error("unexpected render")
+				}
+			}()
+		}
+		wg.Wait()
+		if renders != 1 {
+			t.Fatalf("expected one render, got %v", renders)
+		}
+	})
+
+	t.Run("does not cache render errors, like from a cancelled context", func(t *testing.T) {
+		n := g.Memo(g.El("header", g.Text("Hats")))
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		if err := g.RenderContext(ctx, io.Discard, n); !errors.Is(err, context.Canceled) {
+			t.Fatalf("expected context.Canceled, got %v", err)
+		}
+		var b strings.Builder
+		if err := g.RenderContext(context.Background(), &b, n); err != nil {
+			t.Fatal(err)
+		}
+		if b.String() != "<header>Hats</header>" {
+			t.Fatalf("unexpected output %v", b.String())
+		}
+	})
+
+	t.Run("collects errors from error nodes on every render", func(t *testing.T) {
+		errHat := errors.New("no hats")
+		n := g.Memo(g.El("div", g.ErrNode(errHat)))
+		for i := 0; i < 2; i++ {
+			if s, errs := g.RenderWithErrors(n); s != "<div></div>" || len(errs) != 1 || errs[0] != errHat {
+				t.Fatalf("unexpected output %v and errors %v", s, errs)
+			}
+		}
+		if err := g.Write(io.Discard, n); !errors.Is(err, errHat) {
+			t.Fatalf("expected %v, got %v", errHat, err)
+		}
+	})
+
+	t.Run("collects required assets on every render", func(t *testing.T) {
+		n := g.Memo(g.El("div", g.RequireAsset(g.El("style")), g.InHead(g.El("meta"))))
+		assert.Equal(t, "<div><meta /></div>", n)
+		for i := 0; i < 2; i++ {
+			assert.Equal(t, "<html><head><style></style><meta /></head><body><div></div></body></html>",
+				g.HoistAssets(g.El("html", g.El("head"), g.El("body", n))))
+		}
+	})
+
+	t.Run("errors on write error", func(t *testing.T) {
+		if err := g.Write(&erroringWriter{}, g.Memo(g.Text("hat"))); err == nil {
+			t.FailNow()
+		}
+	})
+}
+
+func TestMemoKey(t *testing.T) {
+	var renders int
+	card := func(key, name string) g.Node {
+		return g.MemoKey(key, func() g.Node {
+			renders++
+			return g.El("div", g.Text(name))
+		})
+	}
+
+	t.Run("renders once per key", func(t *testing.T) {
+		g.ClearMemoKey()
+		renders = 0
+		assert.Equal(t, "<div>Partyhat</div>", card("hat-1", "Partyhat"))
+		assert.Equal(t, "<div>Partyhat</div>", card("hat-1", "Tophat"))
+		assert.Equal(t, "<div>Tophat</div>", card("hat-2", "Tophat"))
+		if renders != 2 {
+			t.Fatalf("expected two renders, got %v", renders)
+		}
+	})
+
+	t.Run("removes the least recently used results above the size", func(t *testing.T) {
+		g.ClearMemoKey()
+		g.SetMemoKeySize(2)
+		t.Cleanup(func() { g.SetMemoKeySize(1000) })
+		renders = 0
+		_ = card("hat-1", "Partyhat").Render()
+		_ = card("hat-2", "Tophat").Render()
+		_ = card("hat-1", "Partyhat").Render()
+		_ = card("hat-3", "Bowler").Render()
+		if renders != 3 {
+			t.Fatalf("expected three renders, got %v", renders)
+		}
+		assert.Equal(t, "<div>Partyhat</div>", card("hat-1", "Changed"))
+		assert.Equal(t, "<div>Changed</div>", card("hat-2", "Changed"))
+	})
+
+	t.Run("renders again after clearing", func(t *testing.T) {
+		g.ClearMemoKey()
+		assert.Equal(t, "<div>Partyhat</div>", card("hat-1", "Partyhat"))
+		g.ClearMemoKey()
+		assert.Equal(t, "<div>Tophat</div>", card("hat-1", "Tophat"))
+	})
+
+	t.Run("does not cache render errors", func(t *testing.T) {
+		g.ClearMemoKey()
+		n := g.MemoKey("hat", func() g.Node { return erroringNode{} })
+		if err := g.Write(io.Discard, n); err == nil {
+			t.FailNow()
+		}
+		assert.Equal(t, "<div>Partyhat</div>", card("hat", "Partyhat"))
+	})
+
+	t.Run("is safe for concurrent use", func(t *testing.T) {
+		g.ClearMemoKey()
+		var wg sync.WaitGroup
+		for i := 0; i < 10; i++ {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				_ = g.MemoKey(strconv.Itoa(i%3), func() g.Node { return g.Text("hat") }).Render()
+			}(i)
+		}
+		wg.Wait()
+	})
+}
+
+func TestMemoCache(t *testing.T) {
+	t.Run("caches results separately from other caches", func(t *testing.T) {
+		g.ClearMemoKey()
+		cards, rows := g.NewMemoCache(10), g.NewMemoCache(10)
+		card := cards.Key("42", func() g.Node { return g.El("div", g.Text("Card")) })
+		row := rows.Key("42", func() g.Node { return g.El("tr", g.Text("Row")) })
+		assert.Equal(t, "<div>Card</div>", card)
+		assert.Equal(t, "<tr>Row</tr>", row)
+		assert.Equal(t, "<p>Shared</p>", g.MemoKey("42", func() g.Node { return g.El("p", g.Text("Shared")) }))
+		assert.Equal(t, "<div>Card</div>", cards.Key("42", func() g.Node { return g.Text("Changed") }))
+	})
+
+	t.Run("renders again after clearing or with size 0", func(t *testing.T) {
+		c := g.NewMemoCache(10)
+		assert.Equal(t, "hat", c.Key("hat", func() g.Node { return g.Text("hat") }))
+		c.Clear()
+		assert.Equal(t, "party", c.Key("hat", func() g.Node { return g.Text("party") }))
+		c.SetSize(0)
+		assert.Equal(t, "top", c.Key("hat", func() g.Node { return g.Text("top") }))
+	})
+}
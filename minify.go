@@ -0,0 +1,214 @@
+package gomponents
+
+import (
+	"io"
+	"strings"
+)
+
+// verbatimElements have content where whitespace is significant, so it is never minified.
+var verbatimElements = map[string]struct{}{
+	"pre":      {},
+	"script":   {},
+	"style":    {},
+	"textarea": {},
+}
+
+// RenderMinified renders n to w like Write, but without insignificant whitespace between tags.
+// Whitespace in text is kept as-is, except at the start and end of the text: there, it is removed next
+// to block elements, and collapsed to a single space next to inline elements, where it is significant.
+// The content of tags, including attribute values, and of elements like "pre", "textarea", "script",
+// and "style" is never changed. This also applies to Raw content.
+func RenderMinified(w io.Writer, n Node) error {
+	mw := &minifyingWriter{w: w, prevBlock: true}
+	if err := render(mw, n); err != nil {
+		return err
+	}
+	return mw.flush()
+}
+
+type minifyState int
+
+const (
+	minifyText = minifyState(iota)
+	minifyTagName
+	minifyTag
+	minifyVerbatim
+	minifyComment
+)
+
+// minifyingWriter removes insignificant whitespace from the HTML written to it, before writing it to w.
+// It's a small state machine over the written bytes, so it handles tags split across writes.
+type minifyingWriter struct {
+	w     io.Writer
+	state minifyState
+	out   []byte
+
+	// ws is pending whitespace in text, which is written or dropped once the next text or tag is known.
+	ws          []byte
+	wsAfterText bool
+	lastWasText bool
+	prevBlock   bool
+
+	// tag is the start of the current tag, until the tag name is known.
+	tag     []byte
+	tagName string
+	closing bool
+	quote   byte
+
+	// end is the sequence that ends verbatim content or a comment, and match how much of it has been seen.
+	end   string
+	match int
+}
+
+func (w *minifyingWriter) Write(p []byte) (int, error) {
+	w.out = w.out[:0]
+	for _, c := range p {
+		w.writeByte(c)
+	}
+	if len(w.out) > 0 {
+		if _, err := w.w.Write(w.out); err != nil {
+			return 0, err
+		}
+	}
+	return len(p), nil
+}
+
+func (w *minifyingWriter) writeByte(c byte) {
+	switch w.state {
+	case minifyText:
+		switch {
+		case isSpace(c):
+			if len(w.ws) == 0 {
+				w.wsAfterText = w.lastWasText
+			}
+			w.ws = append(w.ws, c)
+		case c == '<':
+			w.state = minifyTagName
+			w.tag = append(w.tag[:0], c)
+		default:
+			w.writeText(c)
+		}
+
+	case minifyTagName:
+		if isTagNameByte(c) || (len(w.tag) == 1 && (c == '/' || c == '!')) {
+			w.tag = append(w.tag, c)
+			if string(w.tag) == "<!--" {
+				w.writeWhitespace(true)
+				w.out = append(w.out, w.tag...)
+				w.state = minifyComment
+				w.end, w.match = "-->", 0
+			}
+			return
+		}
+		w.closing = len(w.tag) > 1 && w.tag[1] == '/'
+		w.tagName = strings.ToLower(strings.TrimLeft(string(w.tag[1:]), "/"))
+		if w.tagName == "" {
+			// Not a tag after all, so it's text
+			w.state = minifyText
+			for _, tc := range w.tag {
+				w.writeText(tc)
+			}
+			w.writeByte(c)
+			return
+		}
+		w.writeWhitespace(isBlockElement(w.tagName))
+		w.out = append(w.out, w.tag...)
+		w.state = minifyTag
+		w.quote = 0
+		w.writeByte(c)
+
+	case minifyTag:
+		w.out = append(w.out, c)
+		switch {
+		case w.quote != 0:
+			if c == w.quote {
+				w.quote = 0
+			}
+		case c == '"' || c == '\'':
+			w.quote = c
+		case c == '>':
+			w.prevBlock = isBlockElement(w.tagName)
+			w.lastWasText = false
+			w.state = minifyText
+			if _, ok := verbatimElements[w.tagName]; ok && !w.closing && !IsVoidElement(w.tagName) {
+				w.state = minifyVerbatim
+				w.end, w.match = "</"+w.tagName, 0
+			}
+		}
+
+	case minifyVerbatim, minifyComment:
+		w.out = append(w.out, c)
+		lc := c
+		if 'A' <= lc && lc <= 'Z' {
+			lc += 'a' - 'A'
+		}
+		switch {
+		case lc == w.end[w.match]:
+			w.match++
+		case lc == w.end[0]:
+			w.match = 1
+		default:
+			w.match = 0
+		}
+		if w.match < len(w.end) {
+			return
+		}
+		if w.state == minifyComment {
+			w.state = minifyText
+			w.prevBlock = true
+			w.lastWasText = false
+			return
+		}
+		// The closing tag of the verbatim element has started, so continue in it
+		w.state = minifyTag
+		w.closing = true
+		w.quote = 0
+	}
+}
+
+// writeText writes a text byte, after any pending whitespace.
+func (w *minifyingWriter) writeText(c byte) {
+	if len(w.ws) > 0 {
+		if w.wsAfterText {
+			w.out = append(w.out, w.ws...)
+		} else if !w.prevBlock {
+			w.out = append(w.out, ' ')
+		}
+		w.ws = w.ws[:0]
+	}
+	w.out = append(w.out, c)
+	w.lastWasText = true
+}
+
+// writeWhitespace writes or drops pending whitespace before a tag, depending on whether the tag is a block tag.
+func (w *minifyingWriter) writeWhitespace(nextBlock bool) {
+	if len(w.ws) == 0 {
+		return
+	}
+	if !nextBlock && (w.wsAfterText || !w.prevBlock) {
+		w.out = append(w.out, ' ')
+	}
+	w.ws = w.ws[:0]
+}
+
+// flush anything that's still pending at the end of rendering. Trailing whitespace is dropped.
+func (w *minifyingWriter) flush() error {
+	if w.state != minifyTagName {
+		return nil
+	}
+	_, err := w.w.Write(w.tag)
+	return err
+}
+
+func isSpace(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\n' || c == '\r' || c == '\f'
+}
+
+func isTagNameByte(c byte) bool {
+	return 'a' <= c && c <= 'z' || 'A' <= c && c <= 'Z' || '0' <= c && c <= '9' || c == '-' || c == ':'
+}
+
+func isBlockElement(name string) bool {
+	_, ok := inlineElements[name]
+	return !ok
+}
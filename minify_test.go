@@ -0,0 +1,91 @@
+package gomponents_test
+
+import (
+	"strings"
+	"testing"
+
+	g "github.com/maragudk/gomponents"
+)
+
+func TestRenderMinified(t *testing.T) {
+	t.Run("removes whitespace between block elements", func(t *testing.T) {
+		n := g.Raw("\n<div>\n  <p>Hat</p>\n  <p>Partyhat</p>\n</div>\n")
+		assertMinified(t, "<div><p>Hat</p><p>Partyhat</p></div>", n)
+	})
+
+	t.Run("collapses whitespace next to inline elements to a single space", func(t *testing.T) {
+		n := g.El("p", g.Text("\n  A"), g.Text("  \n"), g.El("b", g.Text("party")), g.Text("\n\t"), g.El("i", g.Text("hat")), g.Text("  \n"))
+		assertMinified(t, "<p>A <b>party</b> <i>hat</i></p>", n)
+	})
+
+	t.Run("keeps whitespace inside text", func(t *testing.T) {
+		n := g.El("div", g.Text("  A   party \n hat  "))
+		assertMinified(t, "<div>A   party \n hat</div>", n)
+	})
+
+	t.Run("does not change attribute values", func(t *testing.T) {
+		n := g.Raw(`<div  class="hat   partyhat" title='a > b'>  <span   id="x">hat</span> </div>`)
+		assertMinified(t, `<div  class="hat   partyhat" title='a > b'><span   id="x">hat</span></div>`, n)
+	})
+
+	t.Run("keeps whitespace in pre, textarea, script, and style", func(t *testing.T) {
+		n := g.Raw("<div>\n  <pre>  hat\n    partyhat  </pre>\n  <textarea> hat </textarea>\n" +
+			"  <script>\n  if (a < b) {}\n</script>\n  <STYLE> p { } </STYLE>\n</div>")
+		assertMinified(t, "<div><pre>  hat\n    partyhat  </pre><textarea> hat </textarea>"+
+			"<script>\n  if (a < b) {}\n</script><STYLE> p { } </STYLE></div>", n)
+	})
+
+	t.Run("keeps comments as-is", func(t *testing.T) {
+		n := g.Raw("<div>\n  <!--  a <hat>  -->\n  <p>Hat</p>\n</div>")
+		assertMinified(t, "<div><!--  a <hat>  --><p>Hat</p></div>", n)
+	})
+
+	t.Run("handles tags split across writes", func(t *testing.T) {
+		n := g.Group([]g.Node{g.Raw("<di"), g.Raw("v>\n <"), g.Raw("p>Hat  "), g.Raw(" </p>\n </div>")})
+		assertMinified(t, "<div><p>Hat</p></div>", n)
+	})
+
+	t.Run("errors on write error", func(t *testing.T) {
+		if err := g.RenderMinified(&erroringWriter{}, g.El("div")); err == nil {
+			t.FailNow()
+		}
+	})
+}
+
+func assertMinified(t *testing.T, expected string, n g.Node) {
+	t.Helper()
+	var b strings.Builder
+	if err := g.RenderMinified(&b, n); err != nil {
+		t.Fatal(err)
+	}
+	if b.String() != expected {
+		t.Fatalf("expected `%v` but got `%v`", expected, b.String())
+	}
+}
+
+func BenchmarkRenderMinified(b *testing.B) {
+	var items []g.Node
+	for i := 0; i < 100; i++ {
+		items = append(items, g.Raw("\n    "), g.El("li", g.Textf("\n      Hat %v\n    ", i)))
+	}
+	n := g.Group([]g.Node{g.Raw("<!doctype html>\n"), g.El("html", g.Raw("\n  "), g.El("body", g.Raw("\n  "),
+		g.El("ul", g.Group(items), g.Raw("\n  ")), g.Raw("\n")), g.Raw("\n"))})
+
+	b.Run("normal", func(b *testing.B) {
+		var sb strings.Builder
+		for i := 0; i < b.N; i++ {
+			sb.Reset()
+			_ = g.Write(&sb, n)
+		}
+		b.ReportMetric(float64(sb.Len()), "bytes")
+	})
+
+	b.Run("minified", func(b *testing.B) {
+		var sb strings.Builder
+		for i := 0; i < b.N; i++ {
+			sb.Reset()
+			_ = g.RenderMinified(&sb, n)
+		}
+		b.ReportMetric(float64(sb.Len()), "bytes")
+	})
+}
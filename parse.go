@@ -0,0 +1,115 @@
+package gomponents
+
+import (
+	"bytes"
+	"io"
+	"strings"
+
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+// Parse HTML from r into a Node tree of Elements, Attributes, Text, and comments,
+// which renders equivalently to the parsed HTML. Use it together with Walk to inspect or transform existing markup.
+// If the HTML starts with a doctype or an "html" element, it's parsed as a complete document.
+// Otherwise, it's parsed as a fragment, like the content of a "template" element, which can be any content,
+// including table parts like a "tr" element.
+// Attributes without a value are parsed as name-only attributes, like "required".
+// Multiple top-level nodes are returned in a Group.
+func Parse(r io.Reader) (Node, error) {
+	b, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	if isDocument(b) {
+		doc, err := html.Parse(bytes.NewReader(b))
+		if err != nil {
+			return nil, err
+		}
+		return convertChildren(doc), nil
+	}
+
+	nodes, err := html.ParseFragment(bytes.NewReader(b), &html.Node{Type: html.ElementNode, Data: "template", DataAtom: atom.Template})
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 1 {
+		return convert(nodes[0]), nil
+	}
+	var children []Node
+	for _, n := range nodes {
+		children = append(children, convert(n))
+	}
+	return Group(children), nil
+}
+
+// isDocument returns whether b starts with a doctype or an html element, ignoring leading whitespace and case.
+func isDocument(b []byte) bool {
+	if len(b) > 512 {
+		b = b[:512]
+	}
+	s := strings.ToLower(string(bytes.TrimSpace(b)))
+	return strings.HasPrefix(s, "<!doctype") || strings.HasPrefix(s, "<html")
+}
+
+// unescapedTextElements have text content that is parsed as-is, not unescaped, with scripting enabled like in browsers,
+// so it's converted to Raw to render the same. Text in script and style elements is rendered unescaped anyway.
+// See https://html.spec.whatwg.org/multipage/parsing.html#parsing-html-fragments
+var unescapedTextElements = map[string]struct{}{
+	"iframe":    {},
+	"noembed":   {},
+	"noframes":  {},
+	"noscript":  {},
+	"plaintext": {},
+	"xmp":       {},
+}
+
+// convert the parsed node n and its children to a Node.
+func convert(n *html.Node) Node {
+	switch n.Type {
+	case html.ElementNode:
+		var children []Node
+		for _, a := range n.Attr {
+			name := a.Key
+			if a.Namespace != "" {
+				name = a.Namespace + ":" + name
+			}
+			if a.Val == "" {
+				children = append(children, Attr(name))
+				continue
+			}
+			children = append(children, Attr(name, a.Val))
+		}
+		for c := n.FirstChild; c != nil; c = c.NextSibling {
+			children = append(children, convert(c))
+		}
+		if n.Namespace != "" {
+			return ForeignEl(n.Data, children...)
+		}
+		return El(n.Data, children...)
+	case html.TextNode:
+		if n.Parent != nil && n.Parent.Type == html.ElementNode && n.Parent.Namespace == "" {
+			if _, ok := unescapedTextElements[n.Parent.Data]; ok {
+				return Raw(n.Data)
+			}
+		}
+		return Text(n.Data)
+	case html.CommentNode:
+		return RawComment(n.Data)
+	case html.DoctypeNode:
+		var b strings.Builder
+		_ = html.Render(&b, n)
+		return Raw(b.String())
+	}
+	return convertChildren(n)
+}
+
+// convertChildren of n to a Group.
+func convertChildren(n *html.Node) Node {
+	var children []Node
+	for c := n.FirstChild; c != nil; c = c.NextSibling {
+		children = append(children, convert(c))
+	}
+	return Group(children)
+}
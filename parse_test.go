@@ -0,0 +1,100 @@
+package gomponents_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	g "github.com/maragudk/gomponents"
+	"github.com/maragudk/gomponents/assert"
+)
+
+func TestParse(t *testing.T) {
+	t.Run("parses a fragment into nodes that render the same", func(t *testing.T) {
+		input := `<div class="hat" id="partyhat"><p>A <b>party</b> hat &amp; a turtle hat.</p><br /><!-- hat --></div>`
+		assert.Equal(t, input, parse(t, input))
+	})
+
+	t.Run("parses multiple top-level nodes into a group", func(t *testing.T) {
+		assert.Equal(t, `<p>Hat</p>hat<span></span>`, parse(t, `<p>Hat</p>hat<span></span>`))
+	})
+
+	t.Run("parses a complete document", func(t *testing.T) {
+		input := `<!DOCTYPE html><html lang="en"><head><title>Hat</title></head><body><p>Hat</p></body></html>`
+		assert.Equal(t, input, parse(t, input))
+	})
+
+	t.Run("parses elements and attributes", func(t *testing.T) {
+		n := parse(t, `<input type="checkbox" required><span hidden>hat</span>`)
+		var names []string
+		g.Walk(n, func(n g.Node) bool {
+			switch n := n.(type) {
+			case g.Element:
+				names = append(names, n.Name())
+			case g.Attribute:
+				names = append(names, n.Name())
+			}
+			return true
+		})
+		if strings.Join(names, ",") != "input,type,required,span,hidden" {
+			t.Fatalf("unexpected names %v", names)
+		}
+	})
+
+	t.Run("normalizes html like browsers do", func(t *testing.T) {
+		assert.Equal(t, `<ul><li>Partyhat</li><li>Turtlehat</li></ul>`, parse(t, `<UL><li>Partyhat<li>Turtlehat`))
+	})
+
+	t.Run("parses table parts as the root", func(t *testing.T) {
+		for _, input := range []string{
+			`<tr class="hat"><td>1</td><th scope="row">2</th></tr>`,
+			`<td colspan="2">hat</td>`,
+			`<thead class="hat"><tr><th>Hat</th></tr></thead><tbody id="hats"><tr><td>Partyhat</td></tr></tbody>`,
+			`<caption class="hat">Hats</caption>`,
+		} {
+			assert.Equal(t, input, parse(t, input))
+		}
+	})
+
+	t.Run("does not escape script content twice", func(t *testing.T) {
+		input := `<script>if (hats < 2 && partyhat) { wear("hat") }</script>`
+		assert.Equal(t, input, parse(t, input))
+	})
+
+	t.Run("does not escape the content of noscript and xmp", func(t *testing.T) {
+		for _, input := range []string{
+			`<noscript><img src="x.gif" height="1" width="1"></noscript>`,
+			`<xmp><b>hat</b> &amp; party</xmp>`,
+			`<div><noscript><iframe src="/hat"></iframe></noscript></div>`,
+		} {
+			assert.Equal(t, input, parse(t, input))
+		}
+	})
+
+	t.Run("parses svg as foreign elements", func(t *testing.T) {
+		input := `<svg viewBox="0 0 10 10"><clipPath id="hat"><circle r="5" /></clipPath></svg>`
+		assert.Equal(t, input, parse(t, input))
+	})
+
+	t.Run("errors on read error", func(t *testing.T) {
+		_, err := g.Parse(erroringReader{})
+		if !errors.Is(err, errWrite) {
+			t.FailNow()
+		}
+	})
+}
+
+func parse(t *testing.T, s string) g.Node {
+	t.Helper()
+	n, err := g.Parse(strings.NewReader(s))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return n
+}
+
+type erroringReader struct{}
+
+func (r erroringReader) Read(p []byte) (int, error) {
+	return 0, errWrite
+}
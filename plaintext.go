@@ -0,0 +1,143 @@
+package gomponents
+
+import (
+	"strings"
+	"unicode"
+)
+
+// paragraphElements are separated by a blank line from their surroundings by RenderText.
+var paragraphElements = setOf("blockquote", "h1", "h2", "h3", "h4", "h5", "h6", "ol", "p", "pre", "table", "ul")
+
+// hiddenElements have content that isn't shown as text, so RenderText skips them.
+var hiddenElements = setOf("head", "script", "style", "template")
+
+// RenderText renders n as plain text without tags, for example for the text/plain alternative of an HTML email,
+// so both can be built from the same Node tree. Text is written unescaped, with whitespace collapsed like browsers do,
+// except in "pre" elements. Block elements start on a new line, and paragraphs and headings are separated by a blank line.
+// Line breaks from "br" are kept, list items are prefixed with "- ", and links are followed by their URL in parentheses.
+// Attributes and the content of elements like "head", "script", and "style" are left out.
+// Raw and other Nodes are rendered, and their HTML is parsed to get the text.
+func RenderText(n Node) string {
+	var r textRenderer
+	r.node(n, false)
+	return strings.TrimSpace(r.b.String())
+}
+
+type textRenderer struct {
+	b        strings.Builder
+	pre      int
+	newlines int
+	space    bool
+}
+
+// node writes the text of n. If parsed is true, n is from parsed HTML, so Nodes that aren't elements
+// or text, like comments, are skipped instead of being parsed again.
+func (r *textRenderer) node(n Node, parsed bool) {
+	switch n := n.(type) {
+	case nil, Attribute, empty:
+	case group:
+		for _, c := range n.children {
+			r.node(c, parsed)
+		}
+	case lazy:
+		r.node(n(), parsed)
+	case text:
+		r.text(string(n))
+	case Element:
+		r.element(n, parsed)
+	default:
+		if parsed || placement(n) == Inside {
+			return
+		}
+		if p, err := Parse(strings.NewReader(n.Render())); err == nil {
+			r.node(p, true)
+		}
+	}
+}
+
+func (r *textRenderer) element(e Element, parsed bool) {
+	if _, ok := hiddenElements[e.name]; ok {
+		return
+	}
+
+	switch e.name {
+	case "br":
+		r.lineBreak()
+		return
+	case "pre":
+		r.pre++
+		defer func() { r.pre-- }()
+	case "li":
+		r.breakLines(1)
+		r.text("- ")
+	}
+
+	breaks := 0
+	if _, ok := paragraphElements[e.name]; ok {
+		breaks = 2
+	} else if isBlockElement(e.name) && e.name != "li" {
+		breaks = 1
+	}
+	r.breakLines(breaks)
+
+	start := r.b.Len()
+	for _, c := range e.children {
+		r.node(c, parsed)
+	}
+
+	if e.name == "a" {
+		// Add the URL, unless it's a fragment or already the link text
+		if a, ok := e.Attribute("href"); ok {
+			if href, _ := a.Value(); href != "" && !strings.HasPrefix(href, "#") && strings.TrimSpace(r.b.String()[start:]) != href {
+				r.text(" (" + href + ")")
+			}
+		}
+	}
+	r.breakLines(breaks)
+}
+
+// text writes s, collapsing whitespace outside of "pre" elements.
+func (r *textRenderer) text(s string) {
+	for _, c := range s {
+		if r.pre == 0 && unicode.IsSpace(c) {
+			r.space = true
+			continue
+		}
+		r.flush()
+		r.b.WriteRune(c)
+	}
+}
+
+// flush pending line breaks or space before the next character.
+func (r *textRenderer) flush() {
+	if r.b.Len() == 0 {
+		r.newlines, r.space = 0, false
+		return
+	}
+	if r.newlines > 0 {
+		r.b.WriteString(strings.Repeat("\n", r.newlines))
+		r.newlines, r.space = 0, false
+		return
+	}
+	if r.space && !strings.HasSuffix(r.b.String(), "\n") {
+		r.b.WriteByte(' ')
+	}
+	r.space = false
+}
+
+// breakLines makes the next text start after n line breaks, if it isn't already.
+func (r *textRenderer) breakLines(n int) {
+	if n > r.newlines {
+		r.newlines = n
+	}
+}
+
+// lineBreak writes a line break from a "br" element, which is kept even if there are more in a row.
+func (r *textRenderer) lineBreak() {
+	r.space = false
+	if r.newlines > 0 {
+		r.newlines++
+		return
+	}
+	r.b.WriteByte('\n')
+}
@@ -0,0 +1,43 @@
+package gomponents_test
+
+import (
+	"testing"
+
+	g "github.com/maragudk/gomponents"
+)
+
+func TestRenderText(t *testing.T) {
+	t.Run("renders the text of an email", func(t *testing.T) {
+		n := g.El("html",
+			g.El("head", g.El("title", g.Text("Hats")), g.El("style", g.Raw("p { color: red; }"))),
+			g.El("body",
+				g.El("h1", g.Text("New hats & caps")),
+				g.El("p", g.Attr("class", "intro"), g.Text("Hi there,\n    we have "), g.El("b", g.Text("new")), g.Text(" hats.")),
+				g.El("ul", g.El("li", g.Text("Partyhat")), g.El("li", g.Text("Turtlehat"))),
+				g.El("div", g.Text("See "), g.El("a", g.Attr("href", "https://example.com/hats"), g.Text("all hats")), g.Text(".")),
+				g.El("div", g.El("a", g.Attr("href", "https://example.com"), g.Text("https://example.com"))),
+				g.El("p", g.Text("Bye,"), g.El("br"), g.Text("The hat shop")),
+				g.El("script", g.Raw("alert(1)")),
+			),
+		)
+		expected := "New hats & caps\n\nHi there, we have new hats.\n\n- Partyhat\n- Turtlehat\n\n" +
+			"See all hats (https://example.com/hats).\nhttps://example.com\n\nBye,\nThe hat shop"
+		if s := g.RenderText(n); s != expected {
+			t.Fatalf("expected\n%q\ngot\n%q", expected, s)
+		}
+	})
+
+	t.Run("keeps whitespace in pre elements", func(t *testing.T) {
+		n := g.El("div", g.Text("Code:"), g.El("pre", g.Text("  hat\n    partyhat")))
+		if s := g.RenderText(n); s != "Code:\n\n  hat\n    partyhat" {
+			t.Fatalf("unexpected text %q", s)
+		}
+	})
+
+	t.Run("gets the text of raw html and skips comments", func(t *testing.T) {
+		n := g.El("div", g.Raw("<p>Party <i>hat</i> &amp; cap</p>"), g.Comment("hidden"), g.Text("<hats>"))
+		if s := g.RenderText(n); s != "Party hat & cap\n\n<hats>" {
+			t.Fatalf("unexpected text %q", s)
+		}
+	})
+}
@@ -0,0 +1,250 @@
+package gomponents
+
+import (
+	"context"
+	"io"
+	"sort"
+	"strings"
+	"unicode/utf8"
+)
+
+// inlineElements are rendered on the same line as their surrounding text when indenting.
+// See https://developer.mozilla.org/en-US/docs/Web/Guide/HTML/Content_categories#phrasing_content
+var inlineElements = map[string]struct{}{
+	"a": {}, "abbr": {}, "b": {}, "bdi": {}, "bdo": {}, "br": {}, "button": {}, "cite": {}, "code": {},
+	"data": {}, "del": {}, "dfn": {}, "em": {}, "i": {}, "img": {}, "input": {}, "ins": {}, "kbd": {},
+	"label": {}, "mark": {}, "q": {}, "s": {}, "samp": {}, "select": {}, "small": {}, "span": {},
+	"strong": {}, "sub": {}, "sup": {}, "textarea": {}, "time": {}, "u": {}, "var": {}, "wbr": {},
+}
+
+// preformattedElements have content where whitespace is significant, so it is never indented.
+var preformattedElements = map[string]struct{}{
+	"listing":  {},
+	"pre":      {},
+	"textarea": {},
+	"xmp":      {},
+}
+
+// RenderIndented renders n to w like Write, but with each nested element on its own line,
+// indented one level further than its parent with the given indent string.
+// Elements with only text and inline elements as content are kept on one line,
+// and the content of preformatted elements like "pre" and "textarea" is rendered as-is, including nested elements.
+// This also applies to elements with a "white-space" style that preserves whitespace, like "white-space: pre-wrap".
+func RenderIndented(w io.Writer, n Node, indent string) error {
+	return RenderIndentedWidth(w, n, indent, 0)
+}
+
+// RenderIndentedWidth renders n to w like RenderIndented, but elements with attributes that would make their line
+// longer than width characters have each attribute on its own line, indented one level further than the element:
+//
+//	<input
+//	  type="text"
+//	  name="hat"
+//	/>
+//
+// Only the whitespace between the attributes changes, so the output is equivalent to RenderIndented.
+// A width of 0 or less means no limit.
+func RenderIndentedWidth(w io.Writer, n Node, indent string, width int) error {
+	p := &prettyPrinter{w: &statefulWriter{w: w}, indent: indent, width: width}
+	return p.node(n, 0)
+}
+
+type prettyPrinter struct {
+	w      *statefulWriter
+	indent string
+	width  int
+}
+
+// node renders n on its own line(s), starting at the given depth.
+func (p *prettyPrinter) node(n Node, depth int) error {
+	switch n := n.(type) {
+	case nil:
+		return nil
+	case group:
+		for _, c := range n.children {
+			if err := p.node(c, depth); err != nil {
+				return err
+			}
+		}
+		return p.w.err
+	case Element:
+		return p.element(n, depth)
+	case nonced:
+		prevCtx := p.w.ctx
+		p.w.ctx = context.WithValue(writerContext(p.w), nonceKey{}, n.nonce)
+		defer func() { p.w.ctx = prevCtx }()
+		return p.node(n.n, depth)
+	}
+	if placement(n) == Inside {
+		return nil
+	}
+	p.w.write(strings.Repeat(p.indent, depth))
+	if err := render(p.w, n); err != nil {
+		return err
+	}
+	p.w.write("\n")
+	return p.w.err
+}
+
+func (p *prettyPrinter) element(e Element, depth int) error {
+	if e.name == "html" && !e.foreign {
+		e = hoistHead(writerContext(p.w), e)
+	}
+	if isCompact(e) {
+		return p.compactElement(e, depth)
+	}
+
+	attrs, err := p.attributes(e)
+	if err != nil {
+		return err
+	}
+	p.w.write(strings.Repeat(p.indent, depth))
+	if p.fits("<"+e.name+strings.Join(attrs, "")+">", depth) {
+		p.w.write("<" + e.name + strings.Join(attrs, ""))
+	} else {
+		p.startTag(e.name, attrs, depth)
+	}
+	p.w.write(">\n")
+
+	for _, c := range e.children {
+		if err := p.node(c, depth+1); err != nil {
+			return err
+		}
+	}
+
+	p.w.write(strings.Repeat(p.indent, depth))
+	p.w.write("</")
+	p.w.write(e.name)
+	p.w.write(">\n")
+	return p.w.err
+}
+
+// compactElement renders e on a single line, or with its attributes on their own lines if it doesn't fit.
+func (p *prettyPrinter) compactElement(e Element, depth int) error {
+	if p.width <= 0 {
+		p.w.write(strings.Repeat(p.indent, depth))
+		if err := e.RenderTo(p.w); err != nil {
+			return err
+		}
+		p.w.write("\n")
+		return p.w.err
+	}
+
+	var b strings.Builder
+	if err := e.RenderTo(&statefulWriter{w: &b, ctx: p.w.ctx}); err != nil {
+		return err
+	}
+	s := b.String()
+	attrs, err := p.attributes(e)
+	if err != nil {
+		return err
+	}
+	tag := "<" + e.name + strings.Join(attrs, "")
+	p.w.write(strings.Repeat(p.indent, depth))
+	if len(attrs) == 0 || p.fits(s, depth) || !strings.HasPrefix(s, tag) {
+		p.w.write(s)
+	} else {
+		p.startTag(e.name, attrs, depth)
+		p.w.write(strings.TrimLeft(s[len(tag):], " "))
+	}
+	p.w.write("\n")
+	return p.w.err
+}
+
+// attributes returns the rendered attributes of e, one for each of its Inside children after merging and sorting,
+// like ` class="hat"`.
+func (p *prettyPrinter) attributes(e Element) ([]string, error) {
+	children := e.children
+	if (e.name == "script" || e.name == "style") && !e.foreign {
+		children = addNonce(writerContext(p.w), e, children)
+	}
+	nodes := mergeAttributes(children)
+	if sortedAttributes(writerContext(p.w)) {
+		sort.SliceStable(nodes, func(i, j int) bool {
+			return attributeLess(nodes[i], nodes[j])
+		})
+	}
+	var attrs []string
+	for _, c := range nodes {
+		var b strings.Builder
+		if err := renderChild(&statefulWriter{w: &b, ctx: p.w.ctx}, c, Inside); err != nil {
+			return nil, err
+		}
+		if b.Len() > 0 {
+			attrs = append(attrs, b.String())
+		}
+	}
+	return attrs, nil
+}
+
+// startTag writes the start tag of an element without the closing ">", with each attribute on its own line.
+func (p *prettyPrinter) startTag(name string, attrs []string, depth int) {
+	p.w.write("<" + name + "\n")
+	for _, a := range attrs {
+		p.w.write(strings.Repeat(p.indent, depth+1) + strings.TrimLeft(a, " ") + "\n")
+	}
+	p.w.write(strings.Repeat(p.indent, depth))
+}
+
+// fits returns whether the first line of s fits within the width when indented to depth.
+func (p *prettyPrinter) fits(s string, depth int) bool {
+	if p.width <= 0 {
+		return true
+	}
+	if i := strings.IndexByte(s, '\n'); i >= 0 {
+		s = s[:i]
+	}
+	return utf8.RuneCountInString(strings.Repeat(p.indent, depth)+s) <= p.width
+}
+
+// isCompact returns whether e should be rendered on a single line, which is the case for self-closing
+// and preformatted elements, and elements with only text and inline elements as content.
+func isCompact(e Element) bool {
+	if e.isSelfClosing() {
+		return true
+	}
+	if isPreformatted(e) {
+		return true
+	}
+	return hasOnlyInlineContent(e.children)
+}
+
+// isPreformatted returns whether whitespace is significant in the content of e,
+// because it's one of the preformattedElements or has a "white-space" style that preserves it.
+func isPreformatted(e Element) bool {
+	if _, ok := preformattedElements[e.name]; ok {
+		return true
+	}
+	a, ok := e.Attribute("style")
+	if !ok {
+		return false
+	}
+	style, _ := a.Value()
+	for _, declaration := range strings.Split(style, ";") {
+		property, value, _ := strings.Cut(declaration, ":")
+		if strings.TrimSpace(strings.ToLower(property)) != "white-space" {
+			continue
+		}
+		switch strings.TrimSpace(strings.ToLower(value)) {
+		case "pre", "pre-wrap", "pre-line", "break-spaces":
+			return true
+		}
+	}
+	return false
+}
+
+func hasOnlyInlineContent(children []Node) bool {
+	for _, c := range children {
+		switch c := c.(type) {
+		case group:
+			if !hasOnlyInlineContent(c.children) {
+				return false
+			}
+		case Element:
+			if _, ok := inlineElements[c.name]; !ok || !hasOnlyInlineContent(c.children) {
+				return false
+			}
+		}
+	}
+	return true
+}
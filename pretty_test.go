@@ -0,0 +1,158 @@
+package gomponents_test
+
+import (
+	"strings"
+	"testing"
+
+	g "github.com/maragudk/gomponents"
+)
+
+func TestRenderIndented(t *testing.T) {
+	t.Run("indents nested elements", func(t *testing.T) {
+		n := g.Group([]g.Node{g.Raw("<!doctype html>"), g.El("html", g.Attr("lang", "en"),
+			g.El("head", g.El("title", g.Text("Hat")), g.El("meta", g.Attr("charset", "utf-8"))),
+			g.El("body", g.El("div", g.Attr("class", "hat"),
+				g.El("p", g.Text("A "), g.El("b", g.Text("party")), g.Text(" hat.")),
+				g.El("ul", g.El("li", g.Text("Partyhat")), g.El("li", g.Text("Turtlehat"))),
+			)),
+		)})
+		expected := `<!doctype html>
+<html lang="en">
+  <head>
+    <title>Hat</title>
+    <meta charset="utf-8" />
+  </head>
+  <body>
+    <div class="hat">
+      <p>A <b>party</b> hat.</p>
+      <ul>
+        <li>Partyhat</li>
+        <li>Turtlehat</li>
+      </ul>
+    </div>
+  </body>
+</html>
+`
+		assertIndented(t, expected, n, "  ")
+	})
+
+	t.Run("puts text in elements with block content on its own line", func(t *testing.T) {
+		n := g.El("div", g.Text("Hat"), g.El("p"))
+		assertIndented(t, "<div>\n\tHat\n\t<p></p>\n</div>\n", n, "\t")
+	})
+
+	t.Run("does not indent the content of pre and textarea", func(t *testing.T) {
+		n := g.El("div",
+			g.El("pre", g.El("code", g.Text("  hat\n    partyhat")), g.El("div")),
+			g.El("textarea", g.Attr("name", "hat"), g.Text("  hat\n")),
+		)
+		expected := "<div>\n  <pre><code>  hat\n    partyhat</code><div></div></pre>\n  <textarea name=\"hat\">  hat\n</textarea>\n</div>\n"
+		assertIndented(t, expected, n, "  ")
+	})
+
+	t.Run("preserves a pre block in a document byte for byte", func(t *testing.T) {
+		code := "  func hat() {\n\t\treturn \"party\"\n  }\n\n    <b>indented</b>\n"
+		pre := g.El("pre", g.Attr("class", "code"), g.Text(code), g.El("span", g.Text("  hat  ")), g.Raw("\n  end"))
+		n := g.El("html", g.El("body", g.El("main", g.El("h1", g.Text("Hats")), pre)))
+		var b strings.Builder
+		if err := g.RenderIndented(&b, n, "  "); err != nil {
+			t.Fatal(err)
+		}
+		if !strings.Contains(b.String(), "\n      "+pre.Render()+"\n") {
+			t.Fatalf("pre block not preserved in `%v`", b.String())
+		}
+	})
+
+	t.Run("does not indent the content of elements with a white-space style that preserves whitespace", func(t *testing.T) {
+		n := g.El("div", g.El("div", g.Attr("style", "color: red; White-Space: pre-wrap"), g.El("p", g.Text(" hat "))))
+		assertIndented(t, "<div>\n  <div style=\"color: red; White-Space: pre-wrap\"><p> hat </p></div>\n</div>\n", n, "  ")
+		n = g.El("div", g.Attr("style", "white-space: nowrap"), g.El("p"))
+		assertIndented(t, "<div style=\"white-space: nowrap\">\n  <p></p>\n</div>\n", n, "  ")
+	})
+
+	t.Run("skips nil nodes", func(t *testing.T) {
+		assertIndented(t, "<div>\n  <p></p>\n</div>\n", g.El("div", nil, g.El("p"), nil), "  ")
+	})
+
+	t.Run("errors on write error", func(t *testing.T) {
+		err := g.RenderIndented(&erroringWriter{}, g.El("div", g.El("p")), "  ")
+		if err == nil {
+			t.FailNow()
+		}
+	})
+}
+
+func TestRenderIndentedWidth(t *testing.T) {
+	t.Run("puts attributes on their own lines if the line is too long", func(t *testing.T) {
+		n := g.El("form", g.Attr("action", "/hats"), g.Attr("method", "post"),
+			g.El("hr", g.Attr("id", "party"), g.Attr("title", "Party"), g.Attr("class", "a"), g.Attr("class", "b")),
+			g.El("p", g.Attr("id", "hat"), g.Attr("class", "hat"), g.Text("Party "), g.El("a", g.Attr("href", "/hat"), g.Text("hat"))),
+			g.El("hr"),
+		)
+		expected := `<form
+  action="/hats"
+  method="post"
+>
+  <hr
+    id="party"
+    title="Party"
+    class="a b"
+  />
+  <p
+    id="hat"
+    class="hat"
+  >Party <a href="/hat">hat</a></p>
+  <hr />
+</form>
+`
+		assertIndentedWidth(t, expected, n, "  ", 30)
+	})
+
+	t.Run("keeps lines that fit", func(t *testing.T) {
+		n := g.El("div", g.Attr("class", "hat"), g.El("p", g.Attr("id", "party"), g.Text("Hat")))
+		assertIndentedWidth(t, "<div class=\"hat\">\n  <p id=\"party\">Hat</p>\n</div>\n", n, "  ", 23)
+	})
+
+	t.Run("renders equivalent html", func(t *testing.T) {
+		n := g.El("div", g.Attr("class", "hat"), g.Attr("data-party", "yes"),
+			g.El("pre", g.Attr("class", "code"), g.Text("  hat\n")),
+			g.El("textarea", g.Attr("name", "hat"), g.Attr("required"), g.Text("  hat")),
+		)
+		var b strings.Builder
+		if err := g.RenderIndentedWidth(&b, n, "\t", 10); err != nil {
+			t.Fatal(err)
+		}
+		expected := "<div\n\tclass=\"hat\"\n\tdata-party=\"yes\"\n>\n\t<pre\n\t\tclass=\"code\"\n\t>  hat\n</pre>\n" +
+			"\t<textarea\n\t\tname=\"hat\"\n\t\trequired\n\t>  hat</textarea>\n</div>\n"
+		if b.String() != expected {
+			t.Fatalf("expected %q, got %q", expected, b.String())
+		}
+	})
+
+	t.Run("does not wrap with a width of 0", func(t *testing.T) {
+		n := g.El("div", g.Attr("class", "hat"), g.El("hr", g.Attr("title", "hat")))
+		assertIndentedWidth(t, "<div class=\"hat\">\n  <hr title=\"hat\" />\n</div>\n", n, "  ", 0)
+	})
+}
+
+func assertIndentedWidth(t *testing.T, expected string, n g.Node, indent string, width int) {
+	t.Helper()
+	var b strings.Builder
+	if err := g.RenderIndentedWidth(&b, n, indent, width); err != nil {
+		t.Fatal(err)
+	}
+	if b.String() != expected {
+		t.Fatalf("expected `%v` but got `%v`", expected, b.String())
+	}
+}
+
+func assertIndented(t *testing.T, expected string, n g.Node, indent string) {
+	t.Helper()
+	var b strings.Builder
+	if err := g.RenderIndented(&b, n, indent); err != nil {
+		t.Fatal(err)
+	}
+	if b.String() != expected {
+		t.Fatalf("expected `%v` but got `%v`", expected, b.String())
+	}
+}
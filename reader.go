@@ -0,0 +1,66 @@
+package gomponents
+
+import (
+	"io"
+	"sync"
+)
+
+// NewReader returns an io.ReadCloser that renders n as it's read, so the whole output is never buffered,
+// for example for passing to http.Post or hashing. The render starts with the first Read,
+// in a goroutine which writes to an io.Pipe, and a render error is returned from Read.
+// Close the reader if it's not read to the end, to stop the render.
+// With io.Copy, n is rendered directly to the destination instead, see io.WriterTo.
+func NewReader(n Node) io.ReadCloser {
+	pr, pw := io.Pipe()
+	return &reader{n: n, pr: pr, pw: pw}
+}
+
+type reader struct {
+	n       Node
+	pr      *io.PipeReader
+	pw      *io.PipeWriter
+	lock    sync.Mutex
+	started bool
+}
+
+// Read satisfies io.Reader.
+func (r *reader) Read(p []byte) (int, error) {
+	r.lock.Lock()
+	if !r.started {
+		r.started = true
+		go func() {
+			_ = r.pw.CloseWithError(Write(r.pw, r.n))
+		}()
+	}
+	r.lock.Unlock()
+	return r.pr.Read(p)
+}
+
+// WriteTo satisfies io.WriterTo. If nothing has been read yet, n is rendered directly to w.
+func (r *reader) WriteTo(w io.Writer) (int64, error) {
+	r.lock.Lock()
+	if r.started {
+		r.lock.Unlock()
+		return io.Copy(w, onlyReader{r})
+	}
+	r.started = true
+	r.lock.Unlock()
+
+	cw := &passthroughCountingWriter{w: w}
+	err := Write(cw, r.n)
+	_ = r.pr.Close()
+	return cw.n, err
+}
+
+// Close satisfies io.Closer. It stops the render, and further reads return io.ErrClosedPipe.
+func (r *reader) Close() error {
+	r.lock.Lock()
+	r.started = true
+	r.lock.Unlock()
+	return r.pr.Close()
+}
+
+// onlyReader hides other methods than Read of the embedded io.Reader, so io.Copy doesn't use them.
+type onlyReader struct {
+	io.Reader
+}
@@ -0,0 +1,61 @@
+package gomponents_test
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	g "github.com/maragudk/gomponents"
+)
+
+func TestNewReader(t *testing.T) {
+	n := g.El("ul", g.El("li", g.Text("Partyhat")), g.El("li", g.Text("Turtlehat")))
+
+	t.Run("renders the node as it is read", func(t *testing.T) {
+		r := g.NewReader(n)
+		var b bytes.Buffer
+		p := make([]byte, 3)
+		for {
+			read, err := r.Read(p)
+			b.Write(p[:read])
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+		}
+		if b.String() != n.Render() {
+			t.Fatalf("unexpected output %v", b.String())
+		}
+	})
+
+	t.Run("renders directly with io.Copy", func(t *testing.T) {
+		var b strings.Builder
+		written, err := io.Copy(&b, g.NewReader(n))
+		if err != nil || b.String() != n.Render() || written != int64(b.Len()) {
+			t.Fatalf("unexpected output %v, %v, and %v", b.String(), written, err)
+		}
+	})
+
+	t.Run("returns the render error from read", func(t *testing.T) {
+		if _, err := io.ReadAll(g.NewReader(g.El("div", erroringNode{}))); err == nil {
+			t.FailNow()
+		}
+	})
+
+	t.Run("stops rendering on close", func(t *testing.T) {
+		r := g.NewReader(g.El("div", g.Text(strings.Repeat("hat", 1000))))
+		if _, err := r.Read(make([]byte, 1)); err != nil {
+			t.Fatal(err)
+		}
+		if err := r.Close(); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := r.Read(make([]byte, 1)); !errors.Is(err, io.ErrClosedPipe) {
+			t.Fatalf("expected io.ErrClosedPipe, got %v", err)
+		}
+	})
+}
@@ -0,0 +1,26 @@
+package gomponents
+
+// Sanitizer removes unsafe content, like scripts and event handler attributes, from untrusted HTML. See Sanitized.
+// A *bluemonday.Policy from github.com/microcosm-cc/bluemonday is a Sanitizer.
+type Sanitizer interface {
+	Sanitize(html string) string
+}
+
+// SanitizerFunc is a function that is also a Sanitizer.
+type SanitizerFunc func(html string) string
+
+// Sanitize satisfies Sanitizer.
+func (f SanitizerFunc) Sanitize(html string) string {
+	return f(html)
+}
+
+// Sanitized creates a DOM Node from the untrusted HTML, like user-submitted rich text,
+// which is run through the Sanitizer s and then rendered like Raw.
+// Use it instead of Raw for HTML that doesn't come from a trusted source.
+// If s is nil, the HTML is escaped like with Text instead, so it's never rendered unsanitized.
+func Sanitized(html string, s Sanitizer) Node {
+	if s == nil {
+		return Text(html)
+	}
+	return Raw(s.Sanitize(html))
+}
@@ -0,0 +1,24 @@
+package gomponents_test
+
+import (
+	"strings"
+	"testing"
+
+	g "github.com/maragudk/gomponents"
+	"github.com/maragudk/gomponents/assert"
+)
+
+func TestSanitized(t *testing.T) {
+	noScripts := g.SanitizerFunc(func(html string) string {
+		return strings.ReplaceAll(html, "<script>alert(1)</script>", "")
+	})
+
+	t.Run("renders the sanitized html", func(t *testing.T) {
+		n := g.El("div", g.Sanitized("<p>Party <b>hat</b></p><script>alert(1)</script>", noScripts))
+		assert.Equal(t, "<div><p>Party <b>hat</b></p></div>", n)
+	})
+
+	t.Run("escapes the html without a sanitizer", func(t *testing.T) {
+		assert.Equal(t, "&lt;script&gt;alert(1)&lt;/script&gt;", g.Sanitized("<script>alert(1)</script>", nil))
+	})
+}
@@ -0,0 +1,59 @@
+//go:build go1.23
+
+package gomponents
+
+import (
+	"context"
+	"io"
+	"iter"
+)
+
+// MapSeq is like Map, but for an iterator, like rows from a database cursor.
+// The items are pulled from seq and mapped with fn while rendering, so they're never all in memory at once.
+// Because of that, the resulting Nodes are rendered like Outside children, and any Inside Nodes are skipped.
+// seq is iterated again for every render.
+func MapSeq[T any](seq iter.Seq[T], fn func(T) Node) Node {
+	return mapSeq[T]{seq: seq, fn: fn}
+}
+
+type mapSeq[T any] struct {
+	seq iter.Seq[T]
+	fn  func(T) Node
+}
+
+func (m mapSeq[T]) Render() string {
+	return renderString(m)
+}
+
+// RenderTo satisfies Renderer.
+func (m mapSeq[T]) RenderTo(w io.Writer) error {
+	return m.RenderContext(writerContext(w), w)
+}
+
+// RenderContext satisfies ContextNode.
+func (m mapSeq[T]) RenderContext(ctx context.Context, w io.Writer) error {
+	sw, ok := w.(*statefulWriter)
+	if !ok {
+		sw = getStatefulWriter(w)
+		defer putStatefulWriter(sw)
+	}
+	prevCtx := sw.ctx
+	sw.ctx = ctx
+	defer func() { sw.ctx = prevCtx }()
+
+	for item := range m.seq {
+		if err := renderChild(sw, m.fn(item), Outside); err != nil {
+			return err
+		}
+	}
+	return sw.err
+}
+
+func (m mapSeq[T]) Place() Placement {
+	return Outside
+}
+
+// String satisfies fmt.Stringer.
+func (m mapSeq[T]) String() string {
+	return m.Render()
+}
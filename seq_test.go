@@ -0,0 +1,60 @@
+//go:build go1.23
+
+package gomponents_test
+
+import (
+	"slices"
+	"testing"
+
+	g "github.com/maragudk/gomponents"
+	"github.com/maragudk/gomponents/assert"
+)
+
+func TestMapSeq(t *testing.T) {
+	t.Run("maps items from the iterator to nodes", func(t *testing.T) {
+		n := g.El("ul", g.MapSeq(slices.Values([]string{"partyhat", "turtlehat"}), func(hat string) g.Node {
+			return g.El("li", g.Text(hat))
+		}))
+		assert.Equal(t, "<ul><li>partyhat</li><li>turtlehat</li></ul>", n)
+	})
+
+	t.Run("renders nothing for an empty iterator", func(t *testing.T) {
+		n := g.El("ul", g.MapSeq(slices.Values([]string(nil)), func(hat string) g.Node {
+			return g.El("li", g.Text(hat))
+		}))
+		assert.Equal(t, "<ul></ul>", n)
+	})
+
+	t.Run("pulls items only while rendering", func(t *testing.T) {
+		var pulled int
+		seq := func(yield func(int) bool) {
+			for i := 0; i < 3; i++ {
+				pulled++
+				if !yield(i) {
+					return
+				}
+			}
+		}
+		n := g.MapSeq(seq, func(i int) g.Node { return g.Textf("%v", i) })
+		if pulled != 0 {
+			t.Fatalf("expected no items pulled before render, got %v", pulled)
+		}
+		assert.Equal(t, "012", n)
+	})
+
+	t.Run("stops pulling items at the first error", func(t *testing.T) {
+		var pulled int
+		seq := func(yield func(int) bool) {
+			for i := 0; i < 3; i++ {
+				pulled++
+				if !yield(i) {
+					return
+				}
+			}
+		}
+		err := g.Write(&limitedWriter{n: 6}, g.MapSeq(seq, func(i int) g.Node { return g.El("p") }))
+		if err == nil || pulled != 2 {
+			t.Fatalf("expected an error after two items, got %v after %v", err, pulled)
+		}
+	})
+}
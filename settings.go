@@ -0,0 +1,151 @@
+package gomponents
+
+import (
+	"context"
+	"io"
+)
+
+// VoidStyle is how void elements like "br" are rendered, see WithVoidStyle.
+type VoidStyle int
+
+const (
+	// VoidStyleXHTML renders void elements like "<br />", which works for both HTML and XHTML. It's the default.
+	VoidStyleXHTML = VoidStyle(iota)
+	// VoidStyleHTML5 renders void elements like "<br>".
+	VoidStyleHTML5
+	// VoidStyleXML renders void elements like "<br/>".
+	VoidStyleXML
+)
+
+type voidStyleKey struct{}
+
+// WithVoidStyle returns a copy of ctx with the VoidStyle s, for rendering with RenderContext.
+// Self-closing foreign elements (see ForeignEl) always keep the slash, because it's required for them in HTML.
+func WithVoidStyle(ctx context.Context, s VoidStyle) context.Context {
+	return context.WithValue(ctx, voidStyleKey{}, s)
+}
+
+// WithSpaceBeforeSlash returns a copy of ctx where self-closing elements are rendered with a space before the slash,
+// like "<br />", if space is true, which is the default, and like "<br/>" otherwise.
+// It's a shorthand for WithVoidStyle with VoidStyleXHTML or VoidStyleXML.
+func WithSpaceBeforeSlash(ctx context.Context, space bool) context.Context {
+	if space {
+		return WithVoidStyle(ctx, VoidStyleXHTML)
+	}
+	return WithVoidStyle(ctx, VoidStyleXML)
+}
+
+// selfClosingEnd returns how to end the opening tag of a self-closing element, depending on the VoidStyle in ctx.
+func selfClosingEnd(ctx context.Context, foreign bool) string {
+	s, _ := ctx.Value(voidStyleKey{}).(VoidStyle)
+	switch {
+	case s == VoidStyleXML:
+		return "/>"
+	case s == VoidStyleHTML5 && !foreign:
+		return ">"
+	default:
+		return " />"
+	}
+}
+
+type sortedAttributesKey struct{}
+
+// WithSortedAttributes returns a copy of ctx where attributes are sorted by name when rendering with RenderContext,
+// so the output doesn't depend on the order attributes are given in, for example for golden file tests.
+// Only attributes created with Attr and the helpers using it are sorted. Other Inside Nodes are rendered after them.
+func WithSortedAttributes(ctx context.Context) context.Context {
+	return context.WithValue(ctx, sortedAttributesKey{}, true)
+}
+
+func sortedAttributes(ctx context.Context) bool {
+	sorted, _ := ctx.Value(sortedAttributesKey{}).(bool)
+	return sorted
+}
+
+// attributeLess reports whether a is rendered before b when attributes are sorted.
+func attributeLess(a, b Node) bool {
+	aa, aok := a.(Attribute)
+	ba, bok := b.(Attribute)
+	if aok && bok {
+		return aa.name < ba.name
+	}
+	return aok && !bok
+}
+
+// Escaper writes s to w, escaped for the output format. See WithEscaper.
+type Escaper func(w io.Writer, s string) error
+
+// EscapeHTML is the default Escaper, which escapes like template.HTMLEscapeString. It's also valid for XML.
+func EscapeHTML(w io.Writer, s string) error {
+	return writeEscaped(w, s)
+}
+
+// EscapeNone is an Escaper that writes s as-is, for plain text output.
+func EscapeNone(w io.Writer, s string) error {
+	_, err := io.WriteString(w, s)
+	return err
+}
+
+type escaperKey struct{}
+
+// WithEscaper returns a copy of ctx with the Escaper e, which is used for Text, Textf, and T nodes
+// when rendering with RenderContext, instead of EscapeHTML. Attribute values are always escaped with EscapeHTML.
+func WithEscaper(ctx context.Context, e Escaper) context.Context {
+	return context.WithValue(ctx, escaperKey{}, e)
+}
+
+// escaper returns the Escaper in ctx, or EscapeHTML if there is none.
+func escaper(ctx context.Context) Escaper {
+	if e, ok := ctx.Value(escaperKey{}).(Escaper); ok && e != nil {
+		return e
+	}
+	return EscapeHTML
+}
+
+// ElementHook is called for each element when rendering with RenderContext, see WithElementHook.
+// It gets the element name and its attributes, merged like when they are rendered (see El),
+// and returns the attributes to render, so it can inspect, change, add, or remove them.
+// The attrs slice is only used for this call, so the hook can modify and return it.
+type ElementHook func(name string, attrs []Attribute) []Attribute
+
+type elementHookKey struct{}
+
+// WithElementHook returns a copy of ctx with the ElementHook h, which is called for each element
+// before its attributes are rendered with RenderContext, like for adding "data-component" attributes in development.
+// Elements in Nodes that don't pass on the context, like NodeFuncs, are rendered without it.
+func WithElementHook(ctx context.Context, h ElementHook) context.Context {
+	return context.WithValue(ctx, elementHookKey{}, h)
+}
+
+// AfterElementHook is called with the element name after each element is rendered with RenderContext,
+// see WithAfterElementHook.
+type AfterElementHook func(name string)
+
+type afterElementHookKey struct{}
+
+// WithAfterElementHook returns a copy of ctx with the AfterElementHook h, which is called after each element
+// is rendered with RenderContext, including its children. Together with an ElementHook, it can be used for
+// instrumentation like timing. It's not called if rendering the element fails.
+func WithAfterElementHook(ctx context.Context, h AfterElementHook) context.Context {
+	return context.WithValue(ctx, afterElementHookKey{}, h)
+}
+
+// hookAttributes returns the Inside children with the attributes from the ElementHook h,
+// followed by the other Inside Nodes.
+func hookAttributes(h ElementHook, name string, children []Node) []Node {
+	var attrs []Attribute
+	var others []Node
+	for _, c := range mergeAttributes(children) {
+		if a, ok := c.(Attribute); ok {
+			attrs = append(attrs, a)
+			continue
+		}
+		others = append(others, c)
+	}
+	attrs = h(name, attrs)
+	nodes := make([]Node, 0, len(attrs)+len(others))
+	for _, a := range attrs {
+		nodes = append(nodes, a)
+	}
+	return append(nodes, others...)
+}
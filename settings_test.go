@@ -0,0 +1,165 @@
+package gomponents_test
+
+import (
+	"context"
+	"io"
+	"strings"
+	"testing"
+
+	g "github.com/maragudk/gomponents"
+)
+
+func TestWithVoidStyle(t *testing.T) {
+	n := g.El("div", g.El("br"), g.El("img", g.Attr("src", "hat.png")), g.ForeignEl("circle"))
+
+	tests := []struct {
+		name     string
+		style    g.VoidStyle
+		expected string
+	}{
+		{"xhtml", g.VoidStyleXHTML, `<div><br /><img src="hat.png" /><circle /></div>`},
+		{"html5", g.VoidStyleHTML5, `<div><br><img src="hat.png"><circle /></div>`},
+		{"xml", g.VoidStyleXML, `<div><br/><img src="hat.png"/><circle/></div>`},
+	}
+	for _, test := range tests {
+		t.Run("renders void elements in "+test.name+" style", func(t *testing.T) {
+			var b strings.Builder
+			if err := g.RenderContext(g.WithVoidStyle(context.Background(), test.style), &b, n); err != nil {
+				t.Fatal(err)
+			}
+			if b.String() != test.expected {
+				t.Fatalf("expected `%v` but got `%v`", test.expected, b.String())
+			}
+		})
+	}
+
+	t.Run("renders void elements in xhtml style by default", func(t *testing.T) {
+		if n.Render() != `<div><br /><img src="hat.png" /><circle /></div>` {
+			t.FailNow()
+		}
+	})
+}
+
+func TestWithSpaceBeforeSlash(t *testing.T) {
+	n := g.El("div", g.El("br"), g.ForeignEl("circle"))
+
+	t.Run("renders self-closing elements with or without a space before the slash", func(t *testing.T) {
+		for space, expected := range map[bool]string{true: `<div><br /><circle /></div>`, false: `<div><br/><circle/></div>`} {
+			var b strings.Builder
+			if err := g.RenderContext(g.WithSpaceBeforeSlash(context.Background(), space), &b, n); err != nil {
+				t.Fatal(err)
+			}
+			if b.String() != expected {
+				t.Fatalf("expected %v, got %v", expected, b.String())
+			}
+		}
+	})
+}
+
+func TestWithSortedAttributes(t *testing.T) {
+	t.Run("renders attributes sorted by name", func(t *testing.T) {
+		n := g.El("div", g.Attr("id", "hat"), g.El("input", g.Attr("type", "text"), g.Attr("name", "hat"), g.Attr("required")),
+			g.Group([]g.Node{g.Attr("class", "party")}), g.Attr("class", "turtle"), g.Attr("data-hat", "yes"))
+		var b strings.Builder
+		if err := g.RenderContext(g.WithSortedAttributes(context.Background()), &b, n); err != nil {
+			t.Fatal(err)
+		}
+		expected := `<div class="party turtle" data-hat="yes" id="hat"><input name="hat" required type="text" /></div>`
+		if b.String() != expected {
+			t.Fatalf("expected `%v` but got `%v`", expected, b.String())
+		}
+	})
+
+	t.Run("renders other inside nodes after the attributes", func(t *testing.T) {
+		var b strings.Builder
+		if err := g.RenderContext(g.WithSortedAttributes(context.Background()), &b, g.El("div", insider{}, g.Attr("id", "hat"))); err != nil {
+			t.Fatal(err)
+		}
+		if b.String() != `<div id="hat" insider></div>` {
+			t.Fatalf("unexpected output %v", b.String())
+		}
+	})
+
+	t.Run("does not sort attributes by default", func(t *testing.T) {
+		if g.El("div", g.Attr("id", "hat"), g.Attr("class", "party")).Render() != `<div id="hat" class="party"></div>` {
+			t.FailNow()
+		}
+	})
+}
+
+func TestWithEscaper(t *testing.T) {
+	n := g.El("p", g.Attr("title", "<hat>"), g.Text("Party & <turtle> hats"), g.Textf("%v", "<3"), g.T("<top>"))
+
+	t.Run("escapes text with the escaper", func(t *testing.T) {
+		quoting := func(w io.Writer, s string) error {
+			_, err := io.WriteString(w, "'"+s+"'")
+			return err
+		}
+		var b strings.Builder
+		if err := g.RenderContext(g.WithEscaper(context.Background(), quoting), &b, n); err != nil {
+			t.Fatal(err)
+		}
+		if b.String() != `<p title="&lt;hat&gt;">'Party & <turtle> hats''<3''<top>'</p>` {
+			t.Fatalf("unexpected output %v", b.String())
+		}
+	})
+
+	t.Run("does not escape with EscapeNone", func(t *testing.T) {
+		var b strings.Builder
+		if err := g.RenderContext(g.WithEscaper(context.Background(), g.EscapeNone), &b, g.Text("Party & <turtle> hats")); err != nil {
+			t.Fatal(err)
+		}
+		if b.String() != `Party & <turtle> hats` {
+			t.Fatalf("unexpected output %v", b.String())
+		}
+	})
+
+	t.Run("escapes html by default", func(t *testing.T) {
+		if n.Render() != `<p title="&lt;hat&gt;">Party &amp; &lt;turtle&gt; hats&lt;3&lt;top&gt;</p>` {
+			t.Fatalf("unexpected output %v", n.Render())
+		}
+	})
+}
+
+func TestWithElementHook(t *testing.T) {
+	render := func(t *testing.T, ctx context.Context, n g.Node) string {
+		t.Helper()
+		var b strings.Builder
+		if err := g.RenderContext(ctx, &b, n); err != nil {
+			t.Fatal(err)
+		}
+		return b.String()
+	}
+
+	t.Run("renders the attributes returned by the hook", func(t *testing.T) {
+		ctx := g.WithElementHook(context.Background(), func(name string, attrs []g.Attribute) []g.Attribute {
+			if name == "section" {
+				attrs = append(attrs, g.Attr("data-component", "hats").(g.Attribute))
+			}
+			var kept []g.Attribute
+			for _, a := range attrs {
+				if a.Name() != "hidden" {
+					kept = append(kept, a)
+				}
+			}
+			return kept
+		})
+		n := g.El("section", g.Attr("class", "hats"), g.Attr("class", "party"), g.Attr("hidden"), insider{},
+			g.El("img", g.Attr("src", "hat.png"), g.Attr("hidden")))
+		expected := `<section class="hats party" data-component="hats" insider><img src="hat.png" /></section>`
+		if s := render(t, ctx, n); s != expected {
+			t.Fatalf("expected %v, got %v", expected, s)
+		}
+	})
+
+	t.Run("calls the after hook after each element", func(t *testing.T) {
+		var names []string
+		ctx := g.WithAfterElementHook(context.Background(), func(name string) {
+			names = append(names, name)
+		})
+		_ = render(t, ctx, g.El("ul", g.El("li", g.El("br")), g.El("li")))
+		if strings.Join(names, ",") != "br,li,li,ul" {
+			t.Fatalf("unexpected names %v", names)
+		}
+	})
+}
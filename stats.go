@@ -0,0 +1,67 @@
+package gomponents
+
+// Stats about a Node tree and its rendered output, see RenderStats.
+type Stats struct {
+	// Bytes is the length of the rendered output.
+	Bytes int
+	// Nodes is the number of Nodes in the tree, like elements, attributes, and text, but not Groups.
+	Nodes int
+	// Elements is the number of Elements in the tree.
+	Elements int
+	// Texts is the number of text Nodes in the tree, created with Text, Textf, or T.
+	Texts int
+	// Depth is the maximum nesting depth of Elements. A single Element has depth 1.
+	Depth int
+}
+
+// RenderStats renders n and returns Stats about it, for example to check in tests that a component
+// doesn't render too much. Like with Walk, only the Nodes reachable through Elements and Groups are counted.
+// Render errors are ignored, like with Render.
+func RenderStats(n Node) Stats {
+	var s Stats
+	countNodes(n, 1, &s)
+	var w countingWriter
+	_ = render(&w, n)
+	s.Bytes = w.n
+	return s
+}
+
+// countNodes counts n and its children in s, with n at the given element depth.
+func countNodes(n Node, depth int, s *Stats) {
+	switch n := n.(type) {
+	case nil:
+		return
+	case group:
+		for _, c := range n.children {
+			countNodes(c, depth, s)
+		}
+		return
+	case Element:
+		s.Elements++
+		if depth > s.Depth {
+			s.Depth = depth
+		}
+		for _, c := range n.children {
+			countNodes(c, depth+1, s)
+		}
+	case text, translation:
+		s.Texts++
+	}
+	s.Nodes++
+}
+
+// countingWriter counts the bytes written to it, and discards them.
+type countingWriter struct {
+	n int
+}
+
+func (w *countingWriter) Write(p []byte) (int, error) {
+	w.n += len(p)
+	return len(p), nil
+}
+
+// WriteString satisfies io.StringWriter, so strings are counted without conversion to bytes.
+func (w *countingWriter) WriteString(s string) (int, error) {
+	w.n += len(s)
+	return len(s), nil
+}
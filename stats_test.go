@@ -0,0 +1,29 @@
+package gomponents_test
+
+import (
+	"testing"
+
+	g "github.com/maragudk/gomponents"
+)
+
+func TestRenderStats(t *testing.T) {
+	t.Run("returns stats about the node tree and rendered output", func(t *testing.T) {
+		n := g.El("div", g.Attr("class", "hat"),
+			g.El("ul", g.Map([]string{"party", "turtle"}, func(hat string) g.Node {
+				return g.El("li", g.Text(hat))
+			})),
+			g.T("Hats"), g.Raw("<br>"),
+		)
+		s := g.RenderStats(n)
+		expected := g.Stats{Bytes: len(n.Render()), Nodes: 9, Elements: 4, Texts: 3, Depth: 3}
+		if s != expected {
+			t.Fatalf("expected %+v but got %+v", expected, s)
+		}
+	})
+
+	t.Run("returns zero stats for empty nodes", func(t *testing.T) {
+		if s := g.RenderStats(g.Group(nil)); s != (g.Stats{}) {
+			t.Fatalf("unexpected stats %+v", s)
+		}
+	})
+}
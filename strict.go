@@ -0,0 +1,100 @@
+package gomponents
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ElStrict creates an element DOM Node like El, but panics if name is not a known HTML element name
+// or a custom element name, which contains a hyphen like "hat-list". Use it to catch typos like "dvi" early.
+// Names are case-insensitive.
+func ElStrict(name string, children ...Node) Element {
+	if !IsKnownElement(name) {
+		panic(fmt.Sprintf("unknown element name %q", name))
+	}
+	return El(name, children...)
+}
+
+// AttrStrict creates an attr DOM Node like Attr, but panics if name is not a known HTML attribute name,
+// a data or aria attribute name like "data-hat" or "aria-label", or an event handler name like "onclick".
+// Use it to catch typos like "clas" early. Names are case-insensitive.
+func AttrStrict(name string, value ...string) Node {
+	if !IsKnownAttribute(name) {
+		panic(fmt.Sprintf("unknown attribute name %q", name))
+	}
+	return Attr(name, value...)
+}
+
+// IsKnownElement returns whether name is a known HTML element name or a custom element name. See ElStrict.
+func IsKnownElement(name string) bool {
+	name = strings.ToLower(name)
+	if _, ok := knownElements[name]; ok {
+		return true
+	}
+	return strings.Contains(name, "-") && name[0] >= 'a' && name[0] <= 'z'
+}
+
+// IsKnownAttribute returns whether name is a known HTML attribute name,
+// a data, aria, or event handler attribute name. See AttrStrict.
+func IsKnownAttribute(name string) bool {
+	name = strings.ToLower(name)
+	if _, ok := knownAttributes[name]; ok {
+		return true
+	}
+	for _, prefix := range []string{"data-", "aria-"} {
+		if strings.HasPrefix(name, prefix) && len(name) > len(prefix) {
+			return true
+		}
+	}
+	return strings.HasPrefix(name, "on") && len(name) > 2 && isLetters(name[2:])
+}
+
+func isLetters(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] < 'a' || s[i] > 'z' {
+			return false
+		}
+	}
+	return true
+}
+
+// knownElements are the HTML element names.
+// See https://html.spec.whatwg.org/multipage/indices.html#elements-3
+var knownElements = setOf(
+	"a", "abbr", "address", "area", "article", "aside", "audio", "b", "base", "bdi", "bdo", "blockquote", "body",
+	"br", "button", "canvas", "caption", "cite", "code", "col", "colgroup", "data", "datalist", "dd", "del",
+	"details", "dfn", "dialog", "div", "dl", "dt", "em", "embed", "fieldset", "figcaption", "figure", "footer",
+	"form", "h1", "h2", "h3", "h4", "h5", "h6", "head", "header", "hgroup", "hr", "html", "i", "iframe", "img",
+	"input", "ins", "kbd", "label", "legend", "li", "link", "main", "map", "mark", "math", "menu", "meta",
+	"meter", "nav", "noscript", "object", "ol", "optgroup", "option", "output", "p", "param", "picture", "pre",
+	"progress", "q", "rp", "rt", "ruby", "s", "samp", "script", "search", "section", "select", "slot", "small",
+	"source", "span", "strong", "style", "sub", "summary", "sup", "svg", "table", "tbody", "td", "template",
+	"textarea", "tfoot", "th", "thead", "time", "title", "tr", "track", "u", "ul", "var", "video", "wbr",
+)
+
+// knownAttributes are the HTML attribute names, except event handlers.
+// See https://html.spec.whatwg.org/multipage/indices.html#attributes-3
+var knownAttributes = setOf(
+	"abbr", "accept", "accept-charset", "accesskey", "action", "allow", "allowfullscreen", "alt", "as", "async",
+	"autocapitalize", "autocomplete", "autofocus", "autoplay", "blocking", "charset", "checked", "cite", "class",
+	"color", "cols", "colspan", "content", "contenteditable", "controls", "coords", "crossorigin", "data",
+	"datetime", "decoding", "default", "defer", "dir", "dirname", "disabled", "download", "draggable", "enctype",
+	"enterkeyhint", "fetchpriority", "for", "form", "formaction", "formenctype", "formmethod", "formnovalidate",
+	"formtarget", "headers", "height", "hidden", "high", "href", "hreflang", "http-equiv", "id", "imagesizes",
+	"imagesrcset", "inert", "inputmode", "integrity", "is", "ismap", "itemid", "itemprop", "itemref", "itemscope",
+	"itemtype", "kind", "label", "lang", "list", "loading", "loop", "low", "max", "maxlength", "media", "method",
+	"min", "minlength", "multiple", "muted", "name", "nomodule", "nonce", "novalidate", "open", "optimum", "part",
+	"pattern", "ping", "placeholder", "playsinline", "popover", "popovertarget", "popovertargetaction", "poster",
+	"preload", "readonly", "referrerpolicy", "rel", "required", "reversed", "role", "rows", "rowspan", "sandbox",
+	"scope", "selected", "shadowrootmode", "shape", "size", "sizes", "slot", "span", "spellcheck", "src", "srcdoc",
+	"srclang", "srcset", "start", "step", "style", "tabindex", "target", "title", "translate", "type", "usemap",
+	"value", "width", "wrap", "xmlns",
+)
+
+func setOf(names ...string) map[string]struct{} {
+	m := make(map[string]struct{}, len(names))
+	for _, name := range names {
+		m[name] = struct{}{}
+	}
+	return m
+}
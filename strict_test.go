@@ -0,0 +1,45 @@
+package gomponents_test
+
+import (
+	"testing"
+
+	g "github.com/maragudk/gomponents"
+	"github.com/maragudk/gomponents/assert"
+)
+
+func TestElStrict(t *testing.T) {
+	t.Run("creates known and custom elements", func(t *testing.T) {
+		assert.Equal(t, `<div><hat-list></hat-list></div>`, g.ElStrict("div", g.ElStrict("hat-list")))
+		assert.Equal(t, `<DIV></DIV>`, g.ElStrict("DIV"))
+	})
+
+	t.Run("panics on unknown elements", func(t *testing.T) {
+		defer func() {
+			if r := recover(); r != `unknown element name "dvi"` {
+				t.Fatalf("unexpected panic %v", r)
+			}
+		}()
+		g.ElStrict("dvi")
+	})
+}
+
+func TestAttrStrict(t *testing.T) {
+	t.Run("creates known, data, aria, and event handler attributes", func(t *testing.T) {
+		n := g.El("div", g.AttrStrict("class", "hat"), g.AttrStrict("data-hat", "party"), g.AttrStrict("aria-hidden", "true"),
+			g.AttrStrict("onclick", "wear()"), g.AttrStrict("hidden"))
+		assert.Equal(t, `<div class="hat" data-hat="party" aria-hidden="true" onclick="wear()" hidden></div>`, n)
+	})
+
+	t.Run("panics on unknown attributes", func(t *testing.T) {
+		for _, name := range []string{"clas", "data-", "on", "on-click"} {
+			func() {
+				defer func() {
+					if r := recover(); r == nil {
+						t.Fatalf("expected panic for %v", name)
+					}
+				}()
+				g.AttrStrict(name, "hat")
+			}()
+		}
+	})
+}
@@ -0,0 +1,70 @@
+package gomponents
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// AttrsFromStruct returns attributes for the fields of the struct v (or pointer to struct) with an "attr" tag,
+// which is the attribute name, like:
+//
+//	type Field struct {
+//		Name        string `attr:"name"`
+//		Placeholder string `attr:"placeholder"`
+//		Required    bool   `attr:"required"`
+//		MaxLength   int    `attr:"maxlength"`
+//	}
+//
+// Fields with zero values are skipped. Booleans are rendered as name-only attributes, like "required".
+// Other values are formatted with fmt.Sprint, after dereferencing pointers.
+// Fields with the tag "-" or no tag are skipped, except struct fields without a tag, like embedded structs,
+// whose fields are included as if they were fields of v. Unexported fields are skipped, except embedded structs.
+// AttrsFromStruct panics if v is not a struct or a pointer to one.
+func AttrsFromStruct(v interface{}) Node {
+	rv := reflect.ValueOf(v)
+	for rv.Kind() == reflect.Pointer {
+		if rv.IsNil() {
+			return Empty
+		}
+		rv = rv.Elem()
+	}
+	if rv.Kind() != reflect.Struct {
+		panic(fmt.Sprintf("cannot get attributes from %T, must be a struct", v))
+	}
+	return Group(attrsFromStruct(rv, nil))
+}
+
+func attrsFromStruct(rv reflect.Value, attrs []Node) []Node {
+	rt := rv.Type()
+	for i := 0; i < rt.NumField(); i++ {
+		f := rt.Field(i)
+		v := rv.Field(i)
+		name, hasTag := f.Tag.Lookup("attr")
+		name = strings.TrimSpace(name)
+
+		for v.Kind() == reflect.Pointer && !v.IsNil() {
+			v = v.Elem()
+		}
+
+		if !f.IsExported() && !f.Anonymous {
+			continue
+		}
+		if !hasTag {
+			if v.Kind() == reflect.Struct {
+				attrs = attrsFromStruct(v, attrs)
+			}
+			continue
+		}
+		if name == "-" || name == "" || !f.IsExported() || v.IsZero() {
+			continue
+		}
+
+		if v.Kind() == reflect.Bool {
+			attrs = append(attrs, Attr(name))
+			continue
+		}
+		attrs = append(attrs, Attr(name, fmt.Sprint(v.Interface())))
+	}
+	return attrs
+}
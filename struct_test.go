@@ -0,0 +1,61 @@
+package gomponents_test
+
+import (
+	"testing"
+
+	g "github.com/maragudk/gomponents"
+	"github.com/maragudk/gomponents/assert"
+)
+
+type hatInput struct {
+	Name        string  `attr:"name"`
+	Placeholder string  `attr:"placeholder"`
+	Required    bool    `attr:"required"`
+	Disabled    bool    `attr:"disabled"`
+	MaxLength   int     `attr:"maxlength"`
+	Step        float64 `attr:"step"`
+	Pattern     *string `attr:"pattern"`
+	Internal    string  `attr:"-"`
+	Untagged    string
+	hatInputExtra
+	Data struct {
+		Hat string `attr:"data-hat"`
+	}
+}
+
+type hatInputExtra struct {
+	ID string `attr:"id"`
+}
+
+func TestAttrsFromStruct(t *testing.T) {
+	t.Run("returns attributes for tagged fields with values", func(t *testing.T) {
+		pattern := "[a-z]+"
+		v := hatInput{Name: "hat", Required: true, MaxLength: 10, Step: 0.5, Pattern: &pattern, Internal: "secret",
+			Untagged: "secret", hatInputExtra: hatInputExtra{ID: "partyhat"}}
+		v.Data.Hat = "party"
+		n := g.El("input", g.AttrsFromStruct(v))
+		assert.Equal(t, `<input name="hat" required maxlength="10" step="0.5" pattern="[a-z]+" id="partyhat" data-hat="party" />`, n)
+	})
+
+	t.Run("skips unexported struct fields", func(t *testing.T) {
+		v := struct {
+			Name  string `attr:"name"`
+			extra hatInputExtra
+		}{Name: "hat", extra: hatInputExtra{ID: "partyhat"}}
+		assert.Equal(t, `<input name="hat" />`, g.El("input", g.AttrsFromStruct(v)))
+	})
+
+	t.Run("accepts pointers to structs", func(t *testing.T) {
+		assert.Equal(t, `<input name="hat" />`, g.El("input", g.AttrsFromStruct(&hatInput{Name: "hat"})))
+		assert.Equal(t, `<input />`, g.El("input", g.AttrsFromStruct((*hatInput)(nil))))
+	})
+
+	t.Run("panics on non-structs", func(t *testing.T) {
+		defer func() {
+			if r := recover(); r == nil {
+				t.FailNow()
+			}
+		}()
+		g.AttrsFromStruct("hat")
+	})
+}
@@ -0,0 +1,166 @@
+package svg
+
+import (
+	g "github.com/maragudk/gomponents"
+)
+
+// ViewBox returns an attribute with name "viewBox" and the given value.
+func ViewBox(v string) g.Node {
+	return g.Attr("viewBox", v)
+}
+
+// PreserveAspectRatio returns an attribute with name "preserveAspectRatio" and the given value.
+func PreserveAspectRatio(v string) g.Node {
+	return g.Attr("preserveAspectRatio", v)
+}
+
+// D returns an attribute with name "d" and the given value.
+func D(v string) g.Node {
+	return g.Attr("d", v)
+}
+
+// Fill returns an attribute with name "fill" and the given value.
+func Fill(v string) g.Node {
+	return g.Attr("fill", v)
+}
+
+// FillOpacity returns an attribute with name "fill-opacity" and the given value.
+func FillOpacity(v string) g.Node {
+	return g.Attr("fill-opacity", v)
+}
+
+// FillRule returns an attribute with name "fill-rule" and the given value.
+func FillRule(v string) g.Node {
+	return g.Attr("fill-rule", v)
+}
+
+// Stroke returns an attribute with name "stroke" and the given value.
+func Stroke(v string) g.Node {
+	return g.Attr("stroke", v)
+}
+
+// StrokeWidth returns an attribute with name "stroke-width" and the given value.
+func StrokeWidth(v string) g.Node {
+	return g.Attr("stroke-width", v)
+}
+
+// StrokeLinecap returns an attribute with name "stroke-linecap" and the given value.
+func StrokeLinecap(v string) g.Node {
+	return g.Attr("stroke-linecap", v)
+}
+
+// StrokeLinejoin returns an attribute with name "stroke-linejoin" and the given value.
+func StrokeLinejoin(v string) g.Node {
+	return g.Attr("stroke-linejoin", v)
+}
+
+// StrokeDasharray returns an attribute with name "stroke-dasharray" and the given value.
+func StrokeDasharray(v string) g.Node {
+	return g.Attr("stroke-dasharray", v)
+}
+
+// Opacity returns an attribute with name "opacity" and the given value.
+func Opacity(v string) g.Node {
+	return g.Attr("opacity", v)
+}
+
+// Transform returns an attribute with name "transform" and the given value.
+func Transform(v string) g.Node {
+	return g.Attr("transform", v)
+}
+
+// Cx returns an attribute with name "cx" and the given value.
+func Cx(v string) g.Node {
+	return g.Attr("cx", v)
+}
+
+// Cy returns an attribute with name "cy" and the given value.
+func Cy(v string) g.Node {
+	return g.Attr("cy", v)
+}
+
+// R returns an attribute with name "r" and the given value.
+func R(v string) g.Node {
+	return g.Attr("r", v)
+}
+
+// Rx returns an attribute with name "rx" and the given value.
+func Rx(v string) g.Node {
+	return g.Attr("rx", v)
+}
+
+// Ry returns an attribute with name "ry" and the given value.
+func Ry(v string) g.Node {
+	return g.Attr("ry", v)
+}
+
+// X returns an attribute with name "x" and the given value.
+func X(v string) g.Node {
+	return g.Attr("x", v)
+}
+
+// Y returns an attribute with name "y" and the given value.
+func Y(v string) g.Node {
+	return g.Attr("y", v)
+}
+
+// X1 returns an attribute with name "x1" and the given value.
+func X1(v string) g.Node {
+	return g.Attr("x1", v)
+}
+
+// Y1 returns an attribute with name "y1" and the given value.
+func Y1(v string) g.Node {
+	return g.Attr("y1", v)
+}
+
+// X2 returns an attribute with name "x2" and the given value.
+func X2(v string) g.Node {
+	return g.Attr("x2", v)
+}
+
+// Y2 returns an attribute with name "y2" and the given value.
+func Y2(v string) g.Node {
+	return g.Attr("y2", v)
+}
+
+// Width returns an attribute with name "width" and the given value.
+func Width(v string) g.Node {
+	return g.Attr("width", v)
+}
+
+// Height returns an attribute with name "height" and the given value.
+func Height(v string) g.Node {
+	return g.Attr("height", v)
+}
+
+// Points returns an attribute with name "points" and the given value.
+func Points(v string) g.Node {
+	return g.Attr("points", v)
+}
+
+// Href returns an attribute with name "href" and the given URL.
+// URLs with schemes that can run scripts, like "javascript:", are replaced with "#", see gomponents.URLAttr.
+func Href(v string) g.Node {
+	return g.URLAttr("href", v)
+}
+
+// Offset returns an attribute with name "offset" and the given value.
+func Offset(v string) g.Node {
+	return g.Attr("offset", v)
+}
+
+// StopColor returns an attribute with name "stop-color" and the given value.
+func StopColor(v string) g.Node {
+	return g.Attr("stop-color", v)
+}
+
+// GradientUnits returns an attribute with name "gradientUnits" and the given value.
+func GradientUnits(v string) g.Node {
+	return g.Attr("gradientUnits", v)
+}
+
+// PatternUnits returns an attribute with name "patternUnits" and the given value.
+func PatternUnits(v string) g.Node {
+	return g.Attr("patternUnits", v)
+}
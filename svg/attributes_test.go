@@ -0,0 +1,200 @@
+package svg_test
+
+import (
+	"testing"
+
+	"github.com/maragudk/gomponents/assert"
+	"github.com/maragudk/gomponents/svg"
+)
+
+func TestViewBox(t *testing.T) {
+	t.Run("given a value, returns viewBox=value", func(t *testing.T) {
+		assert.Equal(t, ` viewBox="hat"`, svg.ViewBox("hat"))
+	})
+}
+
+func TestPreserveAspectRatio(t *testing.T) {
+	t.Run("given a value, returns preserveAspectRatio=value", func(t *testing.T) {
+		assert.Equal(t, ` preserveAspectRatio="hat"`, svg.PreserveAspectRatio("hat"))
+	})
+}
+
+func TestD(t *testing.T) {
+	t.Run("given a value, returns d=value", func(t *testing.T) {
+		assert.Equal(t, ` d="hat"`, svg.D("hat"))
+	})
+}
+
+func TestFill(t *testing.T) {
+	t.Run("given a value, returns fill=value", func(t *testing.T) {
+		assert.Equal(t, ` fill="hat"`, svg.Fill("hat"))
+	})
+}
+
+func TestFillOpacity(t *testing.T) {
+	t.Run("given a value, returns fill-opacity=value", func(t *testing.T) {
+		assert.Equal(t, ` fill-opacity="hat"`, svg.FillOpacity("hat"))
+	})
+}
+
+func TestFillRule(t *testing.T) {
+	t.Run("given a value, returns fill-rule=value", func(t *testing.T) {
+		assert.Equal(t, ` fill-rule="hat"`, svg.FillRule("hat"))
+	})
+}
+
+func TestStroke(t *testing.T) {
+	t.Run("given a value, returns stroke=value", func(t *testing.T) {
+		assert.Equal(t, ` stroke="hat"`, svg.Stroke("hat"))
+	})
+}
+
+func TestStrokeWidth(t *testing.T) {
+	t.Run("given a value, returns stroke-width=value", func(t *testing.T) {
+		assert.Equal(t, ` stroke-width="hat"`, svg.StrokeWidth("hat"))
+	})
+}
+
+func TestStrokeLinecap(t *testing.T) {
+	t.Run("given a value, returns stroke-linecap=value", func(t *testing.T) {
+		assert.Equal(t, ` stroke-linecap="hat"`, svg.StrokeLinecap("hat"))
+	})
+}
+
+func TestStrokeLinejoin(t *testing.T) {
+	t.Run("given a value, returns stroke-linejoin=value", func(t *testing.T) {
+		assert.Equal(t, ` stroke-linejoin="hat"`, svg.StrokeLinejoin("hat"))
+	})
+}
+
+func TestStrokeDasharray(t *testing.T) {
+	t.Run("given a value, returns stroke-dasharray=value", func(t *testing.T) {
+		assert.Equal(t, ` stroke-dasharray="hat"`, svg.StrokeDasharray("hat"))
+	})
+}
+
+func TestOpacity(t *testing.T) {
+	t.Run("given a value, returns opacity=value", func(t *testing.T) {
+		assert.Equal(t, ` opacity="hat"`, svg.Opacity("hat"))
+	})
+}
+
+func TestTransform(t *testing.T) {
+	t.Run("given a value, returns transform=value", func(t *testing.T) {
+		assert.Equal(t, ` transform="hat"`, svg.Transform("hat"))
+	})
+}
+
+func TestCx(t *testing.T) {
+	t.Run("given a value, returns cx=value", func(t *testing.T) {
+		assert.Equal(t, ` cx="hat"`, svg.Cx("hat"))
+	})
+}
+
+func TestCy(t *testing.T) {
+	t.Run("given a value, returns cy=value", func(t *testing.T) {
+		assert.Equal(t, ` cy="hat"`, svg.Cy("hat"))
+	})
+}
+
+func TestR(t *testing.T) {
+	t.Run("given a value, returns r=value", func(t *testing.T) {
+		assert.Equal(t, ` r="hat"`, svg.R("hat"))
+	})
+}
+
+func TestRx(t *testing.T) {
+	t.Run("given a value, returns rx=value", func(t *testing.T) {
+		assert.Equal(t, ` rx="hat"`, svg.Rx("hat"))
+	})
+}
+
+func TestRy(t *testing.T) {
+	t.Run("given a value, returns ry=value", func(t *testing.T) {
+		assert.Equal(t, ` ry="hat"`, svg.Ry("hat"))
+	})
+}
+
+func TestX(t *testing.T) {
+	t.Run("given a value, returns x=value", func(t *testing.T) {
+		assert.Equal(t, ` x="hat"`, svg.X("hat"))
+	})
+}
+
+func TestY(t *testing.T) {
+	t.Run("given a value, returns y=value", func(t *testing.T) {
+		assert.Equal(t, ` y="hat"`, svg.Y("hat"))
+	})
+}
+
+func TestX1(t *testing.T) {
+	t.Run("given a value, returns x1=value", func(t *testing.T) {
+		assert.Equal(t, ` x1="hat"`, svg.X1("hat"))
+	})
+}
+
+func TestY1(t *testing.T) {
+	t.Run("given a value, returns y1=value", func(t *testing.T) {
+		assert.Equal(t, ` y1="hat"`, svg.Y1("hat"))
+	})
+}
+
+func TestX2(t *testing.T) {
+	t.Run("given a value, returns x2=value", func(t *testing.T) {
+		assert.Equal(t, ` x2="hat"`, svg.X2("hat"))
+	})
+}
+
+func TestY2(t *testing.T) {
+	t.Run("given a value, returns y2=value", func(t *testing.T) {
+		assert.Equal(t, ` y2="hat"`, svg.Y2("hat"))
+	})
+}
+
+func TestWidth(t *testing.T) {
+	t.Run("given a value, returns width=value", func(t *testing.T) {
+		assert.Equal(t, ` width="hat"`, svg.Width("hat"))
+	})
+}
+
+func TestHeight(t *testing.T) {
+	t.Run("given a value, returns height=value", func(t *testing.T) {
+		assert.Equal(t, ` height="hat"`, svg.Height("hat"))
+	})
+}
+
+func TestPoints(t *testing.T) {
+	t.Run("given a value, returns points=value", func(t *testing.T) {
+		assert.Equal(t, ` points="hat"`, svg.Points("hat"))
+	})
+}
+
+func TestHref(t *testing.T) {
+	t.Run("given a value, returns href=value", func(t *testing.T) {
+		assert.Equal(t, ` href="hat"`, svg.Href("hat"))
+	})
+}
+
+func TestOffset(t *testing.T) {
+	t.Run("given a value, returns offset=value", func(t *testing.T) {
+		assert.Equal(t, ` offset="hat"`, svg.Offset("hat"))
+	})
+}
+
+func TestStopColor(t *testing.T) {
+	t.Run("given a value, returns stop-color=value", func(t *testing.T) {
+		assert.Equal(t, ` stop-color="hat"`, svg.StopColor("hat"))
+	})
+}
+
+func TestGradientUnits(t *testing.T) {
+	t.Run("given a value, returns gradientUnits=value", func(t *testing.T) {
+		assert.Equal(t, ` gradientUnits="hat"`, svg.GradientUnits("hat"))
+	})
+}
+
+func TestPatternUnits(t *testing.T) {
+	t.Run("given a value, returns patternUnits=value", func(t *testing.T) {
+		assert.Equal(t, ` patternUnits="hat"`, svg.PatternUnits("hat"))
+	})
+}
@@ -0,0 +1,121 @@
+// Package svg provides shortcuts and helpers to common SVG elements and attributes.
+// See https://developer.mozilla.org/en-US/docs/Web/SVG/Element for a list of elements,
+// and https://developer.mozilla.org/en-US/docs/Web/SVG/Attribute for a list of attributes.
+//
+// SVG elements are created with gomponents.ForeignEl, so they are rendered self-closing like "<circle />"
+// when they have no content. Element and attribute names are case-sensitive, like "linearGradient" and "viewBox".
+package svg
+
+import (
+	g "github.com/maragudk/gomponents"
+)
+
+// SVG returns an element with name "svg", the SVG namespace xmlns attribute, and the given children.
+func SVG(children ...g.Node) g.Element {
+	return g.ForeignEl("svg", g.Attr("xmlns", "http://www.w3.org/2000/svg"), g.Group(children))
+}
+
+// Circle returns an element with name "circle" and the given children.
+func Circle(children ...g.Node) g.Element {
+	return g.ForeignEl("circle", children...)
+}
+
+// ClipPath returns an element with name "clipPath" and the given children.
+func ClipPath(children ...g.Node) g.Element {
+	return g.ForeignEl("clipPath", children...)
+}
+
+// Defs returns an element with name "defs" and the given children.
+func Defs(children ...g.Node) g.Element {
+	return g.ForeignEl("defs", children...)
+}
+
+// Ellipse returns an element with name "ellipse" and the given children.
+func Ellipse(children ...g.Node) g.Element {
+	return g.ForeignEl("ellipse", children...)
+}
+
+// G returns an element with name "g" and the given children.
+func G(children ...g.Node) g.Element {
+	return g.ForeignEl("g", children...)
+}
+
+// Image returns an element with name "image" and the given children.
+func Image(children ...g.Node) g.Element {
+	return g.ForeignEl("image", children...)
+}
+
+// Line returns an element with name "line" and the given children.
+func Line(children ...g.Node) g.Element {
+	return g.ForeignEl("line", children...)
+}
+
+// LinearGradient returns an element with name "linearGradient" and the given children.
+func LinearGradient(children ...g.Node) g.Element {
+	return g.ForeignEl("linearGradient", children...)
+}
+
+// Mask returns an element with name "mask" and the given children.
+func Mask(children ...g.Node) g.Element {
+	return g.ForeignEl("mask", children...)
+}
+
+// Path returns an element with name "path" and the given children.
+func Path(children ...g.Node) g.Element {
+	return g.ForeignEl("path", children...)
+}
+
+// Pattern returns an element with name "pattern" and the given children.
+func Pattern(children ...g.Node) g.Element {
+	return g.ForeignEl("pattern", children...)
+}
+
+// Polygon returns an element with name "polygon" and the given children.
+func Polygon(children ...g.Node) g.Element {
+	return g.ForeignEl("polygon", children...)
+}
+
+// Polyline returns an element with name "polyline" and the given children.
+func Polyline(children ...g.Node) g.Element {
+	return g.ForeignEl("polyline", children...)
+}
+
+// RadialGradient returns an element with name "radialGradient" and the given children.
+func RadialGradient(children ...g.Node) g.Element {
+	return g.ForeignEl("radialGradient", children...)
+}
+
+// Rect returns an element with name "rect" and the given children.
+func Rect(children ...g.Node) g.Element {
+	return g.ForeignEl("rect", children...)
+}
+
+// Stop returns an element with name "stop" and the given children.
+func Stop(children ...g.Node) g.Element {
+	return g.ForeignEl("stop", children...)
+}
+
+// Symbol returns an element with name "symbol" and the given children.
+func Symbol(children ...g.Node) g.Element {
+	return g.ForeignEl("symbol", children...)
+}
+
+// Text returns an element with name "text" and the given children.
+func Text(children ...g.Node) g.Element {
+	return g.ForeignEl("text", children...)
+}
+
+// TSpan returns an element with name "tspan" and the given children.
+func TSpan(children ...g.Node) g.Element {
+	return g.ForeignEl("tspan", children...)
+}
+
+// Title returns an element with name "title" and the given children.
+func Title(children ...g.Node) g.Element {
+	return g.ForeignEl("title", children...)
+}
+
+// Use returns an element with name "use" and the given children.
+func Use(children ...g.Node) g.Element {
+	return g.ForeignEl("use", children...)
+}
@@ -0,0 +1,154 @@
+package svg_test
+
+import (
+	"testing"
+
+	g "github.com/maragudk/gomponents"
+	"github.com/maragudk/gomponents/assert"
+	"github.com/maragudk/gomponents/svg"
+)
+
+func TestSVG(t *testing.T) {
+	t.Run("returns an svg element with the svg namespace", func(t *testing.T) {
+		assert.Equal(t, `<svg xmlns="http://www.w3.org/2000/svg"><circle /></svg>`, svg.SVG(svg.Circle()))
+	})
+}
+
+func TestCircle(t *testing.T) {
+	t.Run("returns a circle element", func(t *testing.T) {
+		assert.Equal(t, `<circle />`, svg.Circle())
+	})
+}
+
+func TestClipPath(t *testing.T) {
+	t.Run("returns a clipPath element", func(t *testing.T) {
+		assert.Equal(t, `<clipPath />`, svg.ClipPath())
+	})
+}
+
+func TestDefs(t *testing.T) {
+	t.Run("returns a defs element", func(t *testing.T) {
+		assert.Equal(t, `<defs />`, svg.Defs())
+	})
+}
+
+func TestEllipse(t *testing.T) {
+	t.Run("returns an ellipse element", func(t *testing.T) {
+		assert.Equal(t, `<ellipse />`, svg.Ellipse())
+	})
+}
+
+func TestG(t *testing.T) {
+	t.Run("returns a g element", func(t *testing.T) {
+		assert.Equal(t, `<g />`, svg.G())
+	})
+
+	t.Run("renders a closing tag if it has children", func(t *testing.T) {
+		assert.Equal(t, `<g fill="red"><rect width="10" height="10" /></g>`,
+			svg.G(svg.Fill("red"), svg.Rect(svg.Width("10"), svg.Height("10"))))
+	})
+}
+
+func TestImage(t *testing.T) {
+	t.Run("returns an image element", func(t *testing.T) {
+		assert.Equal(t, `<image />`, svg.Image())
+	})
+}
+
+func TestLine(t *testing.T) {
+	t.Run("returns a line element", func(t *testing.T) {
+		assert.Equal(t, `<line />`, svg.Line())
+	})
+}
+
+func TestLinearGradient(t *testing.T) {
+	t.Run("returns a linearGradient element", func(t *testing.T) {
+		assert.Equal(t, `<linearGradient />`, svg.LinearGradient())
+	})
+}
+
+func TestMask(t *testing.T) {
+	t.Run("returns a mask element", func(t *testing.T) {
+		assert.Equal(t, `<mask />`, svg.Mask())
+	})
+}
+
+func TestPath(t *testing.T) {
+	t.Run("returns a path element", func(t *testing.T) {
+		assert.Equal(t, `<path />`, svg.Path())
+	})
+}
+
+func TestPattern(t *testing.T) {
+	t.Run("returns a pattern element", func(t *testing.T) {
+		assert.Equal(t, `<pattern />`, svg.Pattern())
+	})
+}
+
+func TestPolygon(t *testing.T) {
+	t.Run("returns a polygon element", func(t *testing.T) {
+		assert.Equal(t, `<polygon />`, svg.Polygon())
+	})
+}
+
+func TestPolyline(t *testing.T) {
+	t.Run("returns a polyline element", func(t *testing.T) {
+		assert.Equal(t, `<polyline />`, svg.Polyline())
+	})
+}
+
+func TestRadialGradient(t *testing.T) {
+	t.Run("returns a radialGradient element", func(t *testing.T) {
+		assert.Equal(t, `<radialGradient />`, svg.RadialGradient())
+	})
+}
+
+func TestRect(t *testing.T) {
+	t.Run("returns a rect element", func(t *testing.T) {
+		assert.Equal(t, `<rect />`, svg.Rect())
+	})
+}
+
+func TestStop(t *testing.T) {
+	t.Run("returns a stop element", func(t *testing.T) {
+		assert.Equal(t, `<stop />`, svg.Stop())
+	})
+}
+
+func TestSymbol(t *testing.T) {
+	t.Run("returns a symbol element", func(t *testing.T) {
+		assert.Equal(t, `<symbol />`, svg.Symbol())
+	})
+}
+
+func TestText(t *testing.T) {
+	t.Run("returns a text element", func(t *testing.T) {
+		assert.Equal(t, `<text />`, svg.Text())
+	})
+
+	t.Run("escapes text content", func(t *testing.T) {
+		assert.Equal(t, `<text x="0">Hats &amp; caps</text>`, svg.Text(svg.X("0"), g.Text("Hats & caps")))
+	})
+}
+
+func TestTSpan(t *testing.T) {
+	t.Run("returns a tspan element", func(t *testing.T) {
+		assert.Equal(t, `<tspan />`, svg.TSpan())
+	})
+}
+
+func TestTitle(t *testing.T) {
+	t.Run("returns a title element", func(t *testing.T) {
+		assert.Equal(t, `<title />`, svg.Title())
+	})
+
+	t.Run("can be a child of a shape", func(t *testing.T) {
+		assert.Equal(t, `<circle r="5"><title>Hat</title></circle>`, svg.Circle(svg.R("5"), svg.Title(g.Text("Hat"))))
+	})
+}
+
+func TestUse(t *testing.T) {
+	t.Run("returns an use element", func(t *testing.T) {
+		assert.Equal(t, `<use />`, svg.Use())
+	})
+}
@@ -0,0 +1,260 @@
+// Package tailwind provides helpers for Tailwind CSS classes, see https://tailwindcss.com
+package tailwind
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+
+	g "github.com/maragudk/gomponents"
+)
+
+// Group of conflicting utility classes, like "p-2" and "p-4", of which MergeClasses keeps only the last one.
+type Group struct {
+	// Name of the group, like "padding".
+	Name string
+
+	// Classes in the group with these exact names, like "block" and "flex" for display.
+	Classes []string
+
+	// Prefixes of classes in the group, like "p-" for padding.
+	Prefixes []string
+
+	// Value reports whether the rest of a class after one of the Prefixes is a value of this group,
+	// like "sm" after "text-" for the font size. If nil, all values are.
+	// Of the groups with a matching prefix, the one with the longest prefix is used,
+	// and groups with a Value func are tried before groups without.
+	Value func(string) bool
+
+	// Conflicts are the names of other groups that a class in this group overrides, when it comes later,
+	// like "padding-x" for "padding".
+	Conflicts []string
+}
+
+var groups = map[string]Group{}
+
+// RegisterGroups registers groups of conflicting classes for MergeClasses, for example for custom utilities.
+// A group with the same name as an existing one replaces it.
+// It is not safe to call concurrently with MergeClasses, so call it during initialization, like in an init function.
+func RegisterGroups(gs ...Group) {
+	for _, group := range gs {
+		groups[group.Name] = group
+	}
+}
+
+// MergeClasses returns an attribute with name "class" and the given classes, where conflicting utility classes
+// are resolved like tailwind-merge does: of classes in the same Group and with the same variants, like "hover:",
+// only the last one is kept, so "p-2 p-4" is merged to "p-4". Each argument can have several space-separated classes.
+// Duplicate classes are removed, and the remaining classes keep their order. If there are no classes, nothing is rendered.
+// Use it to let components take classes that override their defaults.
+// See RegisterGroups for adding groups of conflicting classes.
+func MergeClasses(classes ...string) g.Node {
+	merged := Merge(classes...)
+	if merged == "" {
+		return g.Empty
+	}
+	return g.Attr("class", merged)
+}
+
+// Merge is like MergeClasses, but returns the merged classes as a string.
+func Merge(classes ...string) string {
+	var all []string
+	for _, c := range classes {
+		all = append(all, strings.Fields(c)...)
+	}
+
+	// Go backwards, so the last class of a group is kept
+	seen := map[string]bool{}
+	kept := make([]bool, len(all))
+	for i := len(all) - 1; i >= 0; i-- {
+		variants, base := parse(all[i])
+		key := variants + base
+		if group, ok := lookup(base); ok {
+			key = variants + "group:" + group.Name
+			if !seen[key] {
+				for _, conflict := range group.Conflicts {
+					seen[variants+"group:"+conflict] = true
+				}
+			}
+		}
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		kept[i] = true
+	}
+
+	var result []string
+	for i, c := range all {
+		if kept[i] {
+			result = append(result, c)
+		}
+	}
+	return strings.Join(result, " ")
+}
+
+// parse class into its variants, sorted and including the important modifier "!",
+// and its base utility class without a negative sign.
+func parse(class string) (string, string) {
+	var variants []string
+	depth := 0
+	start := 0
+	for i := 0; i < len(class); i++ {
+		switch class[i] {
+		case '[':
+			depth++
+		case ']':
+			depth--
+		case ':':
+			if depth == 0 {
+				variants = append(variants, class[start:i])
+				start = i + 1
+			}
+		}
+	}
+	base := class[start:]
+	if strings.HasPrefix(base, "!") {
+		variants = append(variants, "!")
+		base = base[1:]
+	}
+	if strings.HasSuffix(base, "!") {
+		variants = append(variants, "!")
+		base = base[:len(base)-1]
+	}
+	base = strings.TrimPrefix(base, "-")
+	sort.Strings(variants)
+	if len(variants) == 0 {
+		return "", base
+	}
+	return strings.Join(variants, ":") + ":", base
+}
+
+// lookup the Group of the base utility class.
+func lookup(base string) (Group, bool) {
+	var found Group
+	bestScore := 0
+	for _, group := range groups {
+		for _, c := range group.Classes {
+			if c == base {
+				return group, true
+			}
+		}
+		for _, prefix := range group.Prefixes {
+			if !strings.HasPrefix(base, prefix) || len(base) == len(prefix) {
+				continue
+			}
+			if group.Value != nil && !group.Value(base[len(prefix):]) {
+				continue
+			}
+			score := 2 * len(prefix)
+			if group.Value != nil {
+				score++
+			}
+			if score > bestScore || (score == bestScore && group.Name < found.Name) {
+				found, bestScore = group, score
+			}
+		}
+	}
+	return found, bestScore > 0
+}
+
+var (
+	colorPattern  = regexp.MustCompile(`^([a-z]+-\d{2,3}|inherit|current|transparent|black|white)(/\d+)?$`)
+	numberPattern = regexp.MustCompile(`^\d+(\.\d+)?(/\d+)?$`)
+)
+
+func isArbitrary(v string) bool {
+	return strings.HasPrefix(v, "[") && strings.HasSuffix(v, "]")
+}
+
+func isColor(v string) bool {
+	return colorPattern.MatchString(v) || (isArbitrary(v) && (strings.HasPrefix(v, "[#") || strings.HasPrefix(v, "[rgb") ||
+		strings.HasPrefix(v, "[hsl") || strings.HasPrefix(v, "[color:")))
+}
+
+func isNumber(v string) bool {
+	return numberPattern.MatchString(v) || (isArbitrary(v) && !isColor(v))
+}
+
+func oneOf(values ...string) func(string) bool {
+	return func(v string) bool {
+		for _, value := range values {
+			if v == value {
+				return true
+			}
+		}
+		return isArbitrary(v) && !isColor(v)
+	}
+}
+
+// sides returns a group for a utility like padding with the given prefix, and groups for each of its sides.
+func sides(name, prefix string, sides ...string) []Group {
+	var gs []Group
+	all := Group{Name: name, Prefixes: []string{prefix + "-"}}
+	for _, side := range sides {
+		all.Conflicts = append(all.Conflicts, name+"-"+side)
+		gs = append(gs, Group{Name: name + "-" + side, Prefixes: []string{prefix + side + "-"}})
+	}
+	return append(gs, all)
+}
+
+func init() {
+	sizes := []string{"xs", "sm", "base", "md", "lg", "xl", "2xl", "3xl", "4xl", "5xl", "6xl", "7xl", "8xl", "9xl"}
+
+	RegisterGroups(
+		Group{Name: "display", Classes: []string{"block", "inline-block", "inline", "flex", "inline-flex", "grid",
+			"inline-grid", "table", "inline-table", "table-row", "table-cell", "contents", "flow-root", "list-item", "hidden"}},
+		Group{Name: "position", Classes: []string{"static", "fixed", "absolute", "relative", "sticky"}},
+		Group{Name: "visibility", Classes: []string{"visible", "invisible", "collapse"}},
+		Group{Name: "flex-direction", Classes: []string{"flex-row", "flex-row-reverse", "flex-col", "flex-col-reverse"}},
+		Group{Name: "flex-wrap", Classes: []string{"flex-wrap", "flex-wrap-reverse", "flex-nowrap"}},
+		Group{Name: "justify-content", Prefixes: []string{"justify-"},
+			Value: oneOf("normal", "start", "end", "center", "between", "around", "evenly", "stretch")},
+		Group{Name: "align-items", Prefixes: []string{"items-"}, Value: oneOf("start", "end", "center", "baseline", "stretch")},
+		Group{Name: "gap", Prefixes: []string{"gap-"}, Conflicts: []string{"gap-x", "gap-y"}},
+		Group{Name: "gap-x", Prefixes: []string{"gap-x-"}},
+		Group{Name: "gap-y", Prefixes: []string{"gap-y-"}},
+		Group{Name: "width", Prefixes: []string{"w-"}},
+		Group{Name: "min-width", Prefixes: []string{"min-w-"}},
+		Group{Name: "max-width", Prefixes: []string{"max-w-"}},
+		Group{Name: "height", Prefixes: []string{"h-"}},
+		Group{Name: "min-height", Prefixes: []string{"min-h-"}},
+		Group{Name: "max-height", Prefixes: []string{"max-h-"}},
+		Group{Name: "size", Prefixes: []string{"size-"}, Conflicts: []string{"width", "height"}},
+		Group{Name: "z-index", Prefixes: []string{"z-"}},
+		Group{Name: "opacity", Prefixes: []string{"opacity-"}},
+		Group{Name: "overflow", Prefixes: []string{"overflow-"}, Value: oneOf("auto", "hidden", "clip", "visible", "scroll"),
+			Conflicts: []string{"overflow-x", "overflow-y"}},
+		Group{Name: "overflow-x", Prefixes: []string{"overflow-x-"}},
+		Group{Name: "overflow-y", Prefixes: []string{"overflow-y-"}},
+		Group{Name: "font-size", Prefixes: []string{"text-"}, Value: oneOf(sizes...)},
+		Group{Name: "font-weight", Prefixes: []string{"font-"},
+			Value: oneOf("thin", "extralight", "light", "normal", "medium", "semibold", "bold", "extrabold", "black")},
+		Group{Name: "font-family", Classes: []string{"font-sans", "font-serif", "font-mono"}},
+		Group{Name: "text-align", Classes: []string{"text-left", "text-center", "text-right", "text-justify", "text-start", "text-end"}},
+		Group{Name: "text-color", Prefixes: []string{"text-"}, Value: isColor},
+		Group{Name: "line-height", Prefixes: []string{"leading-"}},
+		Group{Name: "letter-spacing", Prefixes: []string{"tracking-"}},
+		Group{Name: "bg-color", Prefixes: []string{"bg-"}, Value: isColor},
+		Group{Name: "border-width", Classes: []string{"border"}, Prefixes: []string{"border-"}, Value: isNumber},
+		Group{Name: "border-color", Prefixes: []string{"border-"}, Value: isColor},
+		Group{Name: "rounded", Classes: []string{"rounded"}, Prefixes: []string{"rounded-"}, Value: oneOf(append(sizes, "none", "full")...)},
+		Group{Name: "shadow", Classes: []string{"shadow"}, Prefixes: []string{"shadow-"}, Value: oneOf(append(sizes, "inner", "none")...)},
+	)
+	RegisterGroups(sides("padding", "p", "x", "y", "s", "e", "t", "r", "b", "l")...)
+	RegisterGroups(sides("margin", "m", "x", "y", "s", "e", "t", "r", "b", "l")...)
+	RegisterGroups(
+		Group{Name: "padding-x", Prefixes: []string{"px-"}, Conflicts: []string{"padding-r", "padding-l", "padding-s", "padding-e"}},
+		Group{Name: "padding-y", Prefixes: []string{"py-"}, Conflicts: []string{"padding-t", "padding-b"}},
+		Group{Name: "margin-x", Prefixes: []string{"mx-"}, Conflicts: []string{"margin-r", "margin-l", "margin-s", "margin-e"}},
+		Group{Name: "margin-y", Prefixes: []string{"my-"}, Conflicts: []string{"margin-t", "margin-b"}},
+		Group{Name: "inset", Prefixes: []string{"inset-"},
+			Conflicts: []string{"inset-x", "inset-y", "top", "right", "bottom", "left"}},
+		Group{Name: "inset-x", Prefixes: []string{"inset-x-"}, Conflicts: []string{"right", "left"}},
+		Group{Name: "inset-y", Prefixes: []string{"inset-y-"}, Conflicts: []string{"top", "bottom"}},
+		Group{Name: "top", Prefixes: []string{"top-"}},
+		Group{Name: "right", Prefixes: []string{"right-"}},
+		Group{Name: "bottom", Prefixes: []string{"bottom-"}},
+		Group{Name: "left", Prefixes: []string{"left-"}},
+	)
+}
@@ -0,0 +1,63 @@
+package tailwind_test
+
+import (
+	"testing"
+
+	g "github.com/maragudk/gomponents"
+	"github.com/maragudk/gomponents/assert"
+	"github.com/maragudk/gomponents/tailwind"
+)
+
+func TestMergeClasses(t *testing.T) {
+	t.Run("keeps the last conflicting class", func(t *testing.T) {
+		assert.Equal(t, ` class="font-bold p-4"`, tailwind.MergeClasses("p-2 font-bold", "p-4"))
+	})
+
+	t.Run("renders nothing without classes", func(t *testing.T) {
+		assert.Equal(t, `<div></div>`, g.El("div", tailwind.MergeClasses("", " ")))
+	})
+}
+
+func TestMerge(t *testing.T) {
+	tests := []struct {
+		classes  []string
+		expected string
+	}{
+		{[]string{"p-2", "p-4"}, "p-4"},
+		{[]string{"block flex", "hidden"}, "hidden"},
+		{[]string{"hat p-2", "hat"}, "p-2 hat"},
+		{[]string{"px-2 py-1", "p-4"}, "p-4"},
+		{[]string{"p-4", "px-2"}, "p-4 px-2"},
+		{[]string{"pl-1 px-2"}, "px-2"},
+		{[]string{"hover:p-2 p-4", "hover:p-3"}, "p-4 hover:p-3"},
+		{[]string{"hover:focus:p-2", "focus:hover:p-3"}, "focus:hover:p-3"},
+		{[]string{"!p-2 p-3", "!p-4"}, "p-3 !p-4"},
+		{[]string{"m-2", "-m-4"}, "-m-4"},
+		{[]string{"text-sm text-red-500", "text-lg"}, "text-red-500 text-lg"},
+		{[]string{"text-red-500 text-center", "text-blue-200/50"}, "text-center text-blue-200/50"},
+		{[]string{"text-[#fff] text-[13px]", "text-black"}, "text-[13px] text-black"},
+		{[]string{"border border-red-500", "border-2 border-t-4"}, "border-red-500 border-2 border-t-4"},
+		{[]string{"bg-red-500", "bg-[#0f0]"}, "bg-[#0f0]"},
+		{[]string{"w-4 h-4", "size-8"}, "size-8"},
+		{[]string{"font-medium font-sans", "font-bold font-mono"}, "font-bold font-mono"},
+		{[]string{"top-0 inset-x-2", "inset-4"}, "inset-4"},
+		{[]string{"rounded rounded-t-lg", "rounded-lg"}, "rounded-t-lg rounded-lg"},
+		{[]string{"md:[&>*]:p-2", "md:[&>*]:p-4"}, "md:[&>*]:p-4"},
+	}
+	for _, test := range tests {
+		t.Run(test.expected, func(t *testing.T) {
+			if merged := tailwind.Merge(test.classes...); merged != test.expected {
+				t.Fatalf("expected %v, got %v", test.expected, merged)
+			}
+		})
+	}
+}
+
+func TestRegisterGroups(t *testing.T) {
+	t.Run("adds conflicting custom classes", func(t *testing.T) {
+		tailwind.RegisterGroups(tailwind.Group{Name: "hat", Classes: []string{"partyhat"}, Prefixes: []string{"hat-"}})
+		if merged := tailwind.Merge("hat-party partyhat", "hat-top"); merged != "hat-top" {
+			t.Fatalf("unexpected merge %v", merged)
+		}
+	})
+}
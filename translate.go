@@ -0,0 +1,77 @@
+package gomponents
+
+import (
+	"context"
+	"fmt"
+	"io"
+)
+
+// Translator looks up the translation for a key, and returns whether there is one. See T.
+type Translator interface {
+	Translate(key string) (string, bool)
+}
+
+// TranslatorFunc is a function that is also a Translator.
+type TranslatorFunc func(key string) (string, bool)
+
+// Translate satisfies Translator.
+func (f TranslatorFunc) Translate(key string) (string, bool) {
+	return f(key)
+}
+
+type translatorKey struct{}
+
+// WithTranslator returns a copy of ctx with the Translator t, for T nodes rendered with RenderContext.
+func WithTranslator(ctx context.Context, t Translator) context.Context {
+	return context.WithValue(ctx, translatorKey{}, t)
+}
+
+// T creates a text DOM Node that is translated when rendered, using the Translator from the render context
+// (see WithTranslator and RenderContext). If args are given, the translation is used as a format string for them,
+// like with Textf. If there is no Translator or no translation for the key, the key itself is used.
+// The result is escaped like with Text, see also WithEscaper, and not escaped in script and style elements.
+func T(key string, args ...interface{}) Node {
+	return translation{key: key, args: args}
+}
+
+type translation struct {
+	key  string
+	args []interface{}
+}
+
+func (t translation) Render() string {
+	return renderString(t)
+}
+
+// RenderTo satisfies Renderer.
+func (t translation) RenderTo(w io.Writer) error {
+	return t.RenderContext(writerContext(w), w)
+}
+
+// RenderContext satisfies ContextNode.
+func (t translation) RenderContext(ctx context.Context, w io.Writer) error {
+	return escaper(ctx)(w, t.text(ctx))
+}
+
+// text returns the translated and formatted text, unescaped.
+func (t translation) text(ctx context.Context) string {
+	s := t.key
+	if tr, ok := ctx.Value(translatorKey{}).(Translator); ok {
+		if translated, ok := tr.Translate(t.key); ok {
+			s = translated
+		}
+	}
+	if len(t.args) > 0 {
+		s = fmt.Sprintf(s, t.args...)
+	}
+	return s
+}
+
+func (t translation) Place() Placement {
+	return Outside
+}
+
+// String satisfies fmt.Stringer.
+func (t translation) String() string {
+	return t.Render()
+}
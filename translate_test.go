@@ -0,0 +1,58 @@
+package gomponents_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	g "github.com/maragudk/gomponents"
+	"github.com/maragudk/gomponents/assert"
+)
+
+func TestT(t *testing.T) {
+	danish := g.TranslatorFunc(func(key string) (string, bool) {
+		translations := map[string]string{
+			"Party hat":  "Festhat",
+			"%v hats <3": "%v hatte <3",
+		}
+		s, ok := translations[key]
+		return s, ok
+	})
+
+	render := func(t *testing.T, n g.Node) string {
+		t.Helper()
+		var b strings.Builder
+		if err := g.RenderContext(g.WithTranslator(context.Background(), danish), &b, n); err != nil {
+			t.Fatal(err)
+		}
+		return b.String()
+	}
+
+	t.Run("translates the key with the translator from the context", func(t *testing.T) {
+		if s := render(t, g.El("p", g.T("Party hat"))); s != "<p>Festhat</p>" {
+			t.Fatalf("unexpected output %v", s)
+		}
+	})
+
+	t.Run("formats the translation with the args and escapes it", func(t *testing.T) {
+		if s := render(t, g.T("%v hats <3", 5)); s != "5 hatte &lt;3" {
+			t.Fatalf("unexpected output %v", s)
+		}
+	})
+
+	t.Run("renders the translation unescaped in script elements, like Text", func(t *testing.T) {
+		if s := render(t, g.El("script", g.T("%v hats <3", 5), g.Text("</script>"))); s != `<script>5 hatte <3<\/script></script>` {
+			t.Fatalf("unexpected output %v", s)
+		}
+	})
+
+	t.Run("falls back to the key if there is no translation", func(t *testing.T) {
+		if s := render(t, g.T("Turtle hat")); s != "Turtle hat" {
+			t.Fatalf("unexpected output %v", s)
+		}
+	})
+
+	t.Run("falls back to the key if there is no translator", func(t *testing.T) {
+		assert.Equal(t, "<p>Hello, Partyhat &amp; co!</p>", g.El("p", g.T("Hello, %v!", "Partyhat & co")))
+	})
+}
@@ -0,0 +1,54 @@
+package gomponents
+
+import (
+	"strings"
+)
+
+// URLAttr creates an attr DOM Node with a URL value, like for "href" and "src".
+// Like html/template does for URLs, only relative URLs and URLs with the schemes "http", "https", and "mailto"
+// are allowed. Other URLs, like "javascript:" and "data:" URLs, are replaced with "#", so user-supplied URLs
+// cannot run scripts. Characters that are not allowed in URLs, like spaces, are percent-encoded,
+// and the value is HTML-escaped like with Attr.
+// Use Attr for URLs with other schemes that are trusted.
+func URLAttr(name, rawurl string) Node {
+	if !isSafeURL(rawurl) {
+		return Attr(name, "#")
+	}
+	return Attr(name, normalizeURL(rawurl))
+}
+
+// isSafeURL returns whether s is a relative URL, or has one of the allowed schemes. See URLAttr.
+func isSafeURL(s string) bool {
+	// Browsers ignore leading and trailing spaces and control characters, and tabs and newlines anywhere
+	s = strings.TrimFunc(s, func(r rune) bool {
+		return r <= ' '
+	})
+	s = strings.NewReplacer("\t", "", "\n", "", "\r", "").Replace(s)
+
+	i := strings.IndexAny(s, ":/?#")
+	if i <= 0 || s[i] != ':' {
+		return true
+	}
+	switch strings.ToLower(s[:i]) {
+	case "http", "https", "mailto":
+		return true
+	default:
+		return false
+	}
+}
+
+// normalizeURL percent-encodes bytes in s that are not allowed in URLs, keeping existing percent-encodings.
+func normalizeURL(s string) string {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if c > ' ' && c < 0x7f && !strings.ContainsRune(`"<>\^`+"`{|}", rune(c)) {
+			b.WriteByte(c)
+			continue
+		}
+		b.WriteByte('%')
+		b.WriteByte("0123456789ABCDEF"[c>>4])
+		b.WriteByte("0123456789ABCDEF"[c&15])
+	}
+	return b.String()
+}
@@ -0,0 +1,33 @@
+package gomponents_test
+
+import (
+	"testing"
+
+	g "github.com/maragudk/gomponents"
+	"github.com/maragudk/gomponents/assert"
+)
+
+func TestURLAttr(t *testing.T) {
+	t.Run("renders relative urls and urls with allowed schemes", func(t *testing.T) {
+		for _, u := range []string{"/hats", "hats/party", "?hat=party", "#hat", "//example.com/hats",
+			"http://example.com", "HTTPS://example.com/hats?hat=party", "mailto:hats@example.com", "hats/party:1"} {
+			assert.Equal(t, ` href="`+u+`"`, g.URLAttr("href", u))
+		}
+	})
+
+	t.Run("replaces urls with other schemes", func(t *testing.T) {
+		for _, u := range []string{"javascript:alert(1)", " JavaScript:alert(1)", "java\tscript:alert(1)",
+			"data:text/html,<script>alert(1)</script>", "vbscript:msgbox(1)"} {
+			assert.Equal(t, ` href="#"`, g.URLAttr("href", u))
+		}
+	})
+
+	t.Run("percent-encodes characters not allowed in urls and escapes the value", func(t *testing.T) {
+		assert.Equal(t, ` href="/hats?name=party%20hat&amp;size=%22big%22&amp;q=%C3%A6"`,
+			g.URLAttr("href", `/hats?name=party hat&size="big"&q=æ`))
+	})
+
+	t.Run("keeps existing percent-encodings", func(t *testing.T) {
+		assert.Equal(t, ` src="/party%20hat.png"`, g.URLAttr("src", "/party%20hat.png"))
+	})
+}
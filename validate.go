@@ -0,0 +1,135 @@
+package gomponents
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"golang.org/x/net/html"
+)
+
+// paragraphClosers are the elements that implicitly close an open "p" element in the browser,
+// so they cannot be inside it. See https://html.spec.whatwg.org/multipage/grouping-content.html#the-p-element
+var paragraphClosers = setOf("address", "article", "aside", "blockquote", "details", "dialog", "div", "dl",
+	"fieldset", "figcaption", "figure", "footer", "form", "h1", "h2", "h3", "h4", "h5", "h6", "header", "hgroup",
+	"hr", "main", "menu", "nav", "ol", "p", "pre", "search", "section", "table", "ul")
+
+// Validate renders n and checks that the result is well-formed HTML, which browsers parse to the same tree.
+// It returns all the problems found, or nil if there are none:
+//   - void elements with children, which are not rendered (see IsVoidElement)
+//   - self-closing elements that are not void, outside of "svg" and "math"
+//   - block elements inside "p" or inline elements, like "<p><div></div></p>"
+//   - duplicate "id" attribute values
+//   - unclosed elements and unexpected end tags, for example from Raw content
+//
+// It's meant for tests and CI, not for use while serving requests.
+func Validate(n Node) []error {
+	var errs []error
+	Walk(n, func(n Node) bool {
+		if e, ok := n.(Element); ok && !e.foreign && IsVoidElement(e.name) && hasOutside(e.children) {
+			errs = append(errs, fmt.Errorf("void element %v cannot have children", e.name))
+		}
+		return true
+	})
+
+	var b strings.Builder
+	if err := Write(&b, n); err != nil {
+		return append(errs, err)
+	}
+	return append(errs, validateHTML(b.String())...)
+}
+
+// validateHTML tokenizes s and checks the tags, see Validate.
+func validateHTML(s string) []error {
+	var errs []error
+	var stack []string
+	foreign := 0
+	ids := map[string]bool{}
+
+	z := html.NewTokenizer(strings.NewReader(s))
+	for {
+		tt := z.Next()
+		if tt == html.ErrorToken {
+			if z.Err() != io.EOF {
+				return append(errs, z.Err())
+			}
+			break
+		}
+
+		t := z.Token()
+		switch tt {
+		case html.StartTagToken, html.SelfClosingTagToken:
+			for _, a := range t.Attr {
+				if a.Key != "id" || a.Namespace != "" {
+					continue
+				}
+				if ids[a.Val] {
+					errs = append(errs, fmt.Errorf("duplicate id %v", a.Val))
+				}
+				ids[a.Val] = true
+			}
+
+			if foreign == 0 && len(stack) > 0 {
+				if parent := stack[len(stack)-1]; !canContain(parent, t.Data) {
+					errs = append(errs, fmt.Errorf("element %v cannot be inside %v", t.Data, parent))
+				}
+			}
+
+			if IsVoidElement(t.Data) && foreign == 0 {
+				continue
+			}
+			if tt == html.SelfClosingTagToken {
+				if foreign == 0 {
+					errs = append(errs, fmt.Errorf("element %v cannot be self-closing", t.Data))
+				}
+				continue
+			}
+			if t.Data == "svg" || t.Data == "math" || foreign > 0 {
+				foreign++
+			}
+			stack = append(stack, t.Data)
+
+		case html.EndTagToken:
+			i := len(stack) - 1
+			for i >= 0 && stack[i] != t.Data {
+				i--
+			}
+			if i < 0 {
+				errs = append(errs, fmt.Errorf("unexpected end tag %v", t.Data))
+				continue
+			}
+			for j := len(stack) - 1; j >= i; j-- {
+				if j > i {
+					errs = append(errs, fmt.Errorf("unclosed element %v", stack[j]))
+				}
+				if foreign > 0 {
+					foreign--
+				}
+			}
+			stack = stack[:i]
+		}
+	}
+
+	for i := len(stack) - 1; i >= 0; i-- {
+		errs = append(errs, fmt.Errorf("unclosed element %v", stack[i]))
+	}
+	return errs
+}
+
+// canContain returns whether the element child can be inside the element parent,
+// where parent is "p" or an inline element. See paragraphClosers and inlineElements.
+func canContain(parent, child string) bool {
+	if _, ok := paragraphClosers[child]; !ok {
+		return true
+	}
+	if parent == "p" {
+		return false
+	}
+	switch parent {
+	case "a", "del", "ins":
+		// These have the content of their parent
+		return true
+	}
+	_, ok := inlineElements[parent]
+	return !ok
+}
@@ -0,0 +1,53 @@
+package gomponents_test
+
+import (
+	"fmt"
+	"testing"
+
+	g "github.com/maragudk/gomponents"
+)
+
+func TestValidate(t *testing.T) {
+	t.Run("returns nil for well-formed html", func(t *testing.T) {
+		n := g.El("html",
+			g.El("head", g.El("title", g.Text("<div>")), g.El("script", g.Raw("if (a < b) {}"))),
+			g.El("body",
+				g.El("div", g.Attr("id", "hat"), g.El("p", g.El("span", g.Text("Party")), g.El("br"), g.Text("hat"))),
+				g.El("a", g.El("div", g.Attr("id", "partyhat"))),
+				g.ForeignEl("svg", g.ForeignEl("circle", g.Attr("r", "1"))),
+			),
+		)
+		if errs := g.Validate(n); errs != nil {
+			t.Fatalf("unexpected errors %v", errs)
+		}
+	})
+
+	tests := []struct {
+		name     string
+		node     g.Node
+		expected []string
+	}{
+		{"void element with children", g.El("br", g.Text("hat")), []string{"void element br cannot have children"}},
+		{"self-closing element", g.Raw("<div />"), []string{"element div cannot be self-closing"}},
+		{"block element inside p", g.El("p", g.El("div")), []string{"element div cannot be inside p"}},
+		{"block element inside inline element", g.El("span", g.El("ul")), []string{"element ul cannot be inside span"}},
+		{"duplicate id", g.Group([]g.Node{g.El("div", g.Attr("id", "hat")), g.El("p", g.Attr("id", "hat"))}), []string{"duplicate id hat"}},
+		{"unclosed element", g.El("div", g.Raw("<span>hat")), []string{"unclosed element span"}},
+		{"unclosed element at the end", g.Raw("<div><p>hat"), []string{"unclosed element p", "unclosed element div"}},
+		{"unexpected end tag", g.El("div", g.Raw("</p>")), []string{"unexpected end tag p"}},
+	}
+	for _, test := range tests {
+		t.Run("reports "+test.name, func(t *testing.T) {
+			errs := g.Validate(test.node)
+			if fmt.Sprint(errs) != fmt.Sprint(test.expected) {
+				t.Fatalf("expected %v, got %v", test.expected, errs)
+			}
+		})
+	}
+
+	t.Run("returns render errors", func(t *testing.T) {
+		if errs := g.Validate(erroringNode{}); len(errs) != 1 {
+			t.Fatalf("expected one error, got %v", errs)
+		}
+	})
+}
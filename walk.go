@@ -0,0 +1,35 @@
+package gomponents
+
+// Walk the Node tree starting at n, calling visit for each Node.
+// If visit returns true for an Element, its children are walked next, before its siblings.
+// Groups are walked transparently: visit isn't called for the Group itself, but for each of its children.
+// Other Nodes like Text are visited, but aren't descended into. Nil Nodes are skipped.
+//
+// Use type assertions in visit to inspect Nodes, like Element for the element name
+// and Attribute for attributes created with Attr:
+//
+//	g.Walk(page, func(n g.Node) bool {
+//		if e, ok := n.(g.Element); ok && e.Name() == "script" {
+//			scripts++
+//		}
+//		return true
+//	})
+func Walk(n Node, visit func(Node) bool) {
+	if n == nil {
+		return
+	}
+	if g, ok := n.(group); ok {
+		for _, c := range g.children {
+			Walk(c, visit)
+		}
+		return
+	}
+	if !visit(n) {
+		return
+	}
+	if e, ok := n.(Element); ok {
+		for _, c := range e.children {
+			Walk(c, visit)
+		}
+	}
+}
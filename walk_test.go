@@ -0,0 +1,79 @@
+package gomponents_test
+
+import (
+	"strings"
+	"testing"
+
+	g "github.com/maragudk/gomponents"
+)
+
+func TestWalk(t *testing.T) {
+	page := g.El("html",
+		g.El("head", g.El("script", g.Attr("src", "/hat.js")), g.El("link", g.Attr("href", "/hat.css"))),
+		g.El("body", g.Attr("class", "hat"), g.Group([]g.Node{
+			g.El("img", g.Attr("src", "/partyhat.png"), g.Attr("hidden")),
+			g.Text("hat"),
+		})),
+	)
+
+	t.Run("visits elements, attributes, and other nodes in order", func(t *testing.T) {
+		var visited []string
+		g.Walk(page, func(n g.Node) bool {
+			switch n := n.(type) {
+			case g.Element:
+				visited = append(visited, n.Name())
+			case g.Attribute:
+				visited = append(visited, "@"+n.Name())
+			default:
+				visited = append(visited, n.Render())
+			}
+			return true
+		})
+		expected := "html head script @src link @href body @class img @src @hidden hat"
+		if strings.Join(visited, " ") != expected {
+			t.Fatalf("expected %v, got %v", expected, strings.Join(visited, " "))
+		}
+	})
+
+	t.Run("exposes attribute values", func(t *testing.T) {
+		var urls []string
+		g.Walk(page, func(n g.Node) bool {
+			if a, ok := n.(g.Attribute); ok && (a.Name() == "src" || a.Name() == "href") {
+				v, _ := a.Value()
+				urls = append(urls, v)
+			}
+			return true
+		})
+		if strings.Join(urls, " ") != "/hat.js /hat.css /partyhat.png" {
+			t.Fatalf("unexpected urls %v", urls)
+		}
+	})
+
+	t.Run("does not descend into elements if visit returns false", func(t *testing.T) {
+		var visited []string
+		g.Walk(page, func(n g.Node) bool {
+			if e, ok := n.(g.Element); ok {
+				visited = append(visited, e.Name())
+				return e.Name() != "head"
+			}
+			return true
+		})
+		if strings.Join(visited, " ") != "html head body img" {
+			t.Fatalf("unexpected visits %v", visited)
+		}
+	})
+
+	t.Run("skips nil nodes", func(t *testing.T) {
+		var visits int
+		g.Walk(g.El("div", nil, g.Group([]g.Node{nil})), func(n g.Node) bool {
+			if n == nil {
+				t.Fatal("visited nil node")
+			}
+			visits++
+			return true
+		})
+		if visits != 1 {
+			t.Fatalf("expected one visit, got %v", visits)
+		}
+	})
+}
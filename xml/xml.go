@@ -0,0 +1,49 @@
+// Package xml provides Nodes for XML documents, like RSS and Atom feeds.
+// Elements are rendered by XML rules: they're self-closing like "<link />" if they have no content,
+// and have a closing tag otherwise. There are no void or raw text elements, so names like "link" and "script"
+// aren't special, and namespaced names like "atom:link" can be used as-is.
+package xml
+
+import (
+	"strings"
+
+	g "github.com/maragudk/gomponents"
+)
+
+// Document returns an XML declaration for UTF-8 encoded XML (see XMLDeclaration), followed by the children.
+func Document(children ...g.Node) g.Node {
+	return g.Group(append([]g.Node{XMLDeclaration()}, children...))
+}
+
+// XMLDeclaration returns the XML declaration for UTF-8 encoded XML, `<?xml version="1.0" encoding="UTF-8"?>`,
+// which must be first in the file, for example for standalone SVG files.
+func XMLDeclaration() g.Node {
+	return ProcessingInstruction("xml", `version="1.0" encoding="UTF-8"`)
+}
+
+// ProcessingInstruction creates a processing instruction with the target and the unescaped data,
+// like `<?xml-stylesheet href="feed.xsl" type="text/xsl"?>`. Without data, it's just the target, like "<?target?>".
+// The data cannot contain "?>", which ends the processing instruction.
+func ProcessingInstruction(target, data string) g.Node {
+	if data == "" {
+		return g.Raw("<?" + target + "?>")
+	}
+	return g.Raw("<?" + target + " " + data + "?>")
+}
+
+// El creates an XML element with a name and child Nodes.
+func El(name string, children ...g.Node) g.Element {
+	return g.ForeignEl(name, children...)
+}
+
+// Attr creates an XML attribute with a name and a value, which is escaped when rendered.
+// Unlike in HTML, XML attributes always have a value.
+func Attr(name, value string) g.Node {
+	return g.Attr(name, value)
+}
+
+// CDATA creates a CDATA section with the unescaped string t, like "<![CDATA[t]]>".
+// Any "]]>" in t is split over two sections, so the section cannot be closed early.
+func CDATA(t string) g.Node {
+	return g.Raw("<![CDATA[" + strings.ReplaceAll(t, "]]>", "]]]]><![CDATA[>") + "]]>")
+}
@@ -0,0 +1,59 @@
+package xml_test
+
+import (
+	"testing"
+
+	g "github.com/maragudk/gomponents"
+	"github.com/maragudk/gomponents/assert"
+	"github.com/maragudk/gomponents/xml"
+)
+
+func TestDocument(t *testing.T) {
+	t.Run("returns an xml declaration and the children", func(t *testing.T) {
+		assert.Equal(t, `<?xml version="1.0" encoding="UTF-8"?><rss><channel /></rss>`, xml.Document(xml.El("rss", xml.El("channel"))))
+	})
+}
+
+func TestXMLDeclaration(t *testing.T) {
+	t.Run("renders the xml declaration for utf-8", func(t *testing.T) {
+		assert.Equal(t, `<?xml version="1.0" encoding="UTF-8"?>`, xml.XMLDeclaration())
+	})
+}
+
+func TestProcessingInstruction(t *testing.T) {
+	t.Run("renders the target and unescaped data", func(t *testing.T) {
+		assert.Equal(t, `<?xml-stylesheet href="feed.xsl?a=1&b=2" type="text/xsl"?>`,
+			xml.ProcessingInstruction("xml-stylesheet", `href="feed.xsl?a=1&b=2" type="text/xsl"`))
+	})
+
+	t.Run("renders just the target without data", func(t *testing.T) {
+		assert.Equal(t, `<?hat?>`, xml.ProcessingInstruction("hat", ""))
+	})
+}
+
+func TestEl(t *testing.T) {
+	t.Run("renders a self-closing element without content", func(t *testing.T) {
+		assert.Equal(t, `<atom:link href="/feed.xml" />`, xml.El("atom:link", xml.Attr("href", "/feed.xml")))
+	})
+
+	t.Run("renders html void and raw text element names like other elements", func(t *testing.T) {
+		assert.Equal(t, `<link>https://example.com/hats</link>`, xml.El("link", g.Text("https://example.com/hats")))
+		assert.Equal(t, `<script>hats &lt; 2 &amp;&amp; &#39;partyhat&#39;</script>`, xml.El("script", g.Text("hats < 2 && 'partyhat'")))
+	})
+}
+
+func TestAttr(t *testing.T) {
+	t.Run("renders an escaped attribute", func(t *testing.T) {
+		assert.Equal(t, ` title="&#39;Party&#39; &amp; hats"`, xml.Attr("title", "'Party' & hats"))
+	})
+}
+
+func TestCDATA(t *testing.T) {
+	t.Run("renders an unescaped cdata section", func(t *testing.T) {
+		assert.Equal(t, `<![CDATA[<p>Hat & co</p>]]>`, xml.CDATA("<p>Hat & co</p>"))
+	})
+
+	t.Run("splits the section on the end marker", func(t *testing.T) {
+		assert.Equal(t, `<![CDATA[hat]]]]><![CDATA[>]]>`, xml.CDATA("hat]]>"))
+	})
+}